@@ -627,14 +627,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/disciplines": {
+        "/curriculum-matrices": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for create discipline",
+                "description": "Endpoint for creating a curriculum matrix version of a course; creating it active deactivates the version active so far",
                 "consumes": [
                     "application/json"
                 ],
@@ -642,23 +642,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discipline"
+                    "curriculum matrix"
                 ],
-                "summary": "Create new discipline",
+                "summary": "Create new curriculum matrix",
                 "parameters": [
                     {
-                        "description": "Create discipline dto",
+                        "description": "Create curriculum matrix dto",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateDisciplineDto"
+                            "$ref": "#/definitions/dto.CreateCurriculumMatrixDto"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -675,14 +675,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/disciplines/list-all": {
+        "/curriculum-matrices/list-all/{courseId}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get many disciplines",
+                "description": "List the curriculum matrix versions of a course",
                 "consumes": [
                     "application/json"
                 ],
@@ -690,14 +690,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discipline"
+                    "curriculum matrix"
+                ],
+                "summary": "Get many curriculum matrices by course",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "course id",
+                        "name": "courseId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get many disciplines",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ManyDisciplinesResponse"
+                            "$ref": "#/definitions/response.ManyCurriculumMatricesResponse"
                         }
                     },
                     "400": {
@@ -706,12 +715,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httperr.RestErr"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -721,14 +724,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/disciplines/list-all/{courseId}": {
+        "/curriculum-matrices/{uuid}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get many disciplines by course",
+                "description": "Get curriculum matrix by uuid, with its disciplines by term",
                 "consumes": [
                     "application/json"
                 ],
@@ -736,14 +739,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discipline by course"
+                    "curriculum matrix"
                 ],
-                "summary": "Get many disciplines by course",
+                "summary": "Curriculum matrix details",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "discipline courseId",
-                        "name": "courseId",
+                        "description": "curriculum matrix uuid",
+                        "name": "uuid",
                         "in": "path",
                         "required": true
                     }
@@ -752,7 +755,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ManyDisciplinesResponse"
+                            "$ref": "#/definitions/response.CurriculumMatrixResponse"
                         }
                     },
                     "400": {
@@ -774,16 +777,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/disciplines/{uuid}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get discipline by uuid",
+                "description": "Delete curriculum matrix by uuid, with its disciplines",
                 "consumes": [
                     "application/json"
                 ],
@@ -791,24 +792,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discipline"
+                    "curriculum matrix"
                 ],
-                "summary": "Discipline details",
+                "summary": "Delete curriculum matrix",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "discipline uuid",
+                        "description": "curriculum matrix uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.DisciplineResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -830,13 +828,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete discipline by uuid",
+                "description": "Endpoint for renaming a curriculum matrix version or (de)activating it; activating it deactivates the other versions of the course",
                 "consumes": [
                     "application/json"
                 ],
@@ -844,16 +842,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discipline"
+                    "curriculum matrix"
                 ],
-                "summary": "Delete discipline",
+                "summary": "Update curriculum matrix",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "discipline uuid",
+                        "description": "curriculum matrix uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update curriculum matrix dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateCurriculumMatrixDto"
+                        }
                     }
                 ],
                 "responses": {
@@ -879,44 +885,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/curriculum-matrices/{uuid}/import": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for update discipline",
+                "description": "Replace the disciplines of a curriculum matrix with the ones listed in a CSV file, sent as the \"file\" form field or as the request body. The first line names the columns: code and term are required, mandatory is optional and defaults to true. Nothing is changed unless every line is valid.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "discipline"
+                    "curriculum matrix"
                 ],
-                "summary": "Update discipline",
+                "summary": "Import the disciplines of a curriculum matrix",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "discipline uuid",
+                        "description": "curriculum matrix uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update discipline dto",
-                        "name": "body",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateDisciplineDto"
-                        }
+                        "type": "file",
+                        "description": "CSV file with the code, term and mandatory columns",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.CurriculumMatrixResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -939,14 +949,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/eligible-disciplines": {
+        "/curriculum-matrix-disciplines": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for create eligible discipline",
+                "description": "Endpoint for placing a discipline of the course in a term of a curriculum matrix, as mandatory (the default) or elective",
                 "consumes": [
                     "application/json"
                 ],
@@ -954,23 +964,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "eligible discipline"
+                    "curriculum matrix discipline"
                 ],
-                "summary": "Create new eligible discipline",
+                "summary": "Add a discipline to a curriculum matrix",
                 "parameters": [
                     {
-                        "description": "Create eligible discipline dto",
+                        "description": "Create curriculum matrix discipline dto",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateEligibleDisciplineDto"
+                            "$ref": "#/definitions/dto.CreateCurriculumMatrixDisciplineDto"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -985,14 +995,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/curriculum-matrix-disciplines/{uuid}": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete eligible discipline by uuid",
+                "description": "Remove a discipline from a curriculum matrix",
                 "consumes": [
                     "application/json"
                 ],
@@ -1000,23 +1012,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "eligible discipline"
+                    "curriculum matrix discipline"
                 ],
-                "summary": "Delete eligible discipline",
+                "summary": "Delete curriculum matrix discipline",
                 "parameters": [
                     {
-                        "description": "Delete eligible discipline dto",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.DeleteEligibleDisciplineDto"
-                        }
+                        "type": "string",
+                        "description": "curriculum matrix discipline uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1037,16 +1047,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/generate-proposal/{parameterizationID}": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for generate proposal",
+                "description": "Endpoint for moving a discipline to another term of the curriculum matrix or changing whether it is mandatory",
                 "consumes": [
                     "application/json"
                 ],
@@ -1054,16 +1062,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "proposal"
+                    "curriculum matrix discipline"
                 ],
-                "summary": "Generate new proposal",
+                "summary": "Update curriculum matrix discipline",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "parameterization uuid",
-                        "name": "parameterizationID",
+                        "description": "curriculum matrix discipline uuid",
+                        "name": "uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update curriculum matrix discipline dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateCurriculumMatrixDisciplineDto"
+                        }
                     }
                 ],
                 "responses": {
@@ -1076,6 +1092,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httperr.RestErr"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1085,14 +1107,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/parameterizations": {
+        "/disciplines": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for create parameterization",
+                "description": "Endpoint for create discipline",
                 "consumes": [
                     "application/json"
                 ],
@@ -1100,17 +1122,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "parameterization"
+                    "discipline"
                 ],
-                "summary": "Create new parameterization",
+                "summary": "Create new discipline",
                 "parameters": [
                     {
-                        "description": "Create parameterization dto",
+                        "description": "Create discipline dto",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateParameterizationDto"
+                            "$ref": "#/definitions/dto.CreateDisciplineDto"
                         }
                     }
                 ],
@@ -1133,14 +1155,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/parameterizations/list-all": {
+        "/disciplines/list-all": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get many parameterization",
+                "description": "Get many disciplines",
                 "consumes": [
                     "application/json"
                 ],
@@ -1148,14 +1170,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "parameterization"
+                    "discipline"
                 ],
-                "summary": "Get many parameterization",
+                "summary": "Get many disciplines",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ManyParameterizationsResponse"
+                            "$ref": "#/definitions/response.ManyDisciplinesResponse"
                         }
                     },
                     "400": {
@@ -1179,14 +1201,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/parameterizations/list-all/{semesterId}": {
+        "/disciplines/list-all/{courseId}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get many parameterizations by semester",
+                "description": "Get many disciplines by course",
                 "consumes": [
                     "application/json"
                 ],
@@ -1194,14 +1216,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "parameterizations by semester"
+                    "discipline by course"
                 ],
-                "summary": "Get many parameterizations by semester",
+                "summary": "Get many disciplines by course",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "parameterization semesterId",
-                        "name": "semesterId",
+                        "description": "discipline courseId",
+                        "name": "courseId",
                         "in": "path",
                         "required": true
                     }
@@ -1210,7 +1232,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ManyParameterizationsResponse"
+                            "$ref": "#/definitions/response.ManyDisciplinesResponse"
                         }
                     },
                     "400": {
@@ -1234,14 +1256,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/parameterizations/{uuid}": {
+        "/disciplines/{uuid}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get parameterization by uuid",
+                "description": "Get discipline by uuid",
                 "consumes": [
                     "application/json"
                 ],
@@ -1249,13 +1271,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "parameterization"
+                    "discipline"
                 ],
-                "summary": "Parameterization details",
+                "summary": "Discipline details",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "parameterization uuid",
+                        "description": "discipline uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -1265,7 +1287,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ParameterizationResponse"
+                            "$ref": "#/definitions/response.DisciplineResponse"
                         }
                     },
                     "400": {
@@ -1294,7 +1316,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete parameterization by uuid",
+                "description": "delete discipline by uuid",
                 "consumes": [
                     "application/json"
                 ],
@@ -1302,13 +1324,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "parameterization"
+                    "discipline"
                 ],
-                "summary": "Delete parameterization",
+                "summary": "Delete discipline",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "parameterization uuid",
+                        "description": "discipline uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -1344,7 +1366,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for update parameterization",
+                "description": "Endpoint for update discipline",
                 "consumes": [
                     "application/json"
                 ],
@@ -1352,23 +1374,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "parameterization"
+                    "discipline"
                 ],
-                "summary": "Update parameterization",
+                "summary": "Update discipline",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "parameterization uuid",
+                        "description": "discipline uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update parameterization dto",
+                        "description": "Update discipline dto",
                         "name": "body",
                         "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateParameterizationDto"
+                            "$ref": "#/definitions/dto.UpdateDisciplineDto"
                         }
                     }
                 ],
@@ -1397,14 +1419,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/professors": {
+        "/eligible-disciplines": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for create professor",
+                "description": "Endpoint for create eligible discipline",
                 "consumes": [
                     "application/json"
                 ],
@@ -1412,17 +1434,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "professor"
+                    "eligible discipline"
                 ],
-                "summary": "Create new professor",
+                "summary": "Create new eligible discipline",
                 "parameters": [
                     {
-                        "description": "Create professor dto",
+                        "description": "Create eligible discipline dto",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateProfessorDto"
+                            "$ref": "#/definitions/dto.CreateEligibleDisciplineDto"
                         }
                     }
                 ],
@@ -1443,16 +1465,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/professors/list-all": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get many professors",
+                "description": "delete eligible discipline by uuid",
                 "consumes": [
                     "application/json"
                 ],
@@ -1460,15 +1480,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "professor"
+                    "eligible discipline"
                 ],
-                "summary": "Get many professors",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Delete eligible discipline",
+                "parameters": [
+                    {
+                        "description": "Delete eligible discipline dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/response.ManyProfessorsResponse"
+                            "$ref": "#/definitions/dto.DeleteEligibleDisciplineDto"
                         }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1491,14 +1519,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/professors/{uuid}": {
-            "get": {
+        "/generate-proposal/{parameterizationID}": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get professor by uuid",
+                "description": "Enqueue a job that generates a proposal, poll the returned job for its status",
                 "consumes": [
                     "application/json"
                 ],
@@ -1506,23 +1534,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "professor"
+                    "proposal"
                 ],
-                "summary": "Professor details",
+                "summary": "Generate new proposal",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "professor uuid",
-                        "name": "uuid",
+                        "description": "parameterization uuid",
+                        "name": "parameterizationID",
                         "in": "path",
                         "required": true
-                    }
-                ],
+                    },
+                    {
+                        "description": "Generate proposal dto, the seed is optional",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenerateProposalDto"
+                        }
+                    }
+                ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/response.ProfessorResponse"
+                            "$ref": "#/definitions/response.ProposalJobResponse"
                         }
                     },
                     "400": {
@@ -1544,14 +1580,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/locked-classes": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete professor by uuid",
+                "description": "Endpoint for placing a class by hand (day, HH:MM start and end, professor and room); every proposal generated from the parameterization keeps it",
                 "consumes": [
                     "application/json"
                 ],
@@ -1559,21 +1597,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "professor"
+                    "locked class"
                 ],
-                "summary": "Delete professor",
+                "summary": "Lock a class in a parameterization",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "professor uuid",
-                        "name": "uuid",
-                        "in": "path",
-                        "required": true
+                        "description": "Create locked class dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateLockedClassDto"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1581,12 +1621,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httperr.RestErr"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1594,14 +1628,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/locked-classes/list-all/{parameterizationId}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for update professor",
+                "description": "List the classes locked in a parameterization",
                 "consumes": [
                     "application/json"
                 ],
@@ -1609,38 +1645,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "professor"
+                    "locked class"
                 ],
-                "summary": "Update professor",
+                "summary": "Get many locked classes by parameterization",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "professor uuid",
-                        "name": "uuid",
+                        "description": "parameterization id",
+                        "name": "parameterizationId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update professor dto",
-                        "name": "body",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateProfessorDto"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
+                            "$ref": "#/definitions/response.ManyLockedClassesResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/httperr.RestErr"
                         }
@@ -1654,14 +1679,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/semesters": {
+        "/locked-classes/proposal/{uuid}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for create semester",
+                "description": "Endpoint for locking classes of an existing proposal in a parameterization: the ones listed in class_uuids or, when none is listed, all of them",
                 "consumes": [
                     "application/json"
                 ],
@@ -1669,63 +1694,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "semester"
+                    "locked class"
                 ],
-                "summary": "Create new semester",
+                "summary": "Lock classes of a proposal",
                 "parameters": [
                     {
-                        "description": "Create semester dto",
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lock proposal classes dto",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateSemesterDto"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
+                            "$ref": "#/definitions/dto.LockProposalClassesDto"
                         }
                     }
-                }
-            }
-        },
-        "/semesters/list-all": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get many semesters",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "semester"
                 ],
-                "summary": "Get many semesters",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.ManySemestersResponse"
-                        }
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1748,14 +1740,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/semesters/{uuid}": {
+        "/locked-classes/{uuid}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get semester by uuid",
+                "description": "Get locked class by uuid",
                 "consumes": [
                     "application/json"
                 ],
@@ -1763,13 +1755,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "semester"
+                    "locked class"
                 ],
-                "summary": "Semester details",
+                "summary": "Locked class details",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "semester uuid",
+                        "description": "locked class uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
@@ -1779,7 +1771,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.SemesterResponse"
+                            "$ref": "#/definitions/response.LockedClassResponse"
                         }
                     },
                     "400": {
@@ -1808,7 +1800,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete semester by uuid",
+                "description": "Unlock a class, leaving its discipline to the generation",
                 "consumes": [
                     "application/json"
                 ],
@@ -1816,21 +1808,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "semester"
+                    "locked class"
                 ],
-                "summary": "Delete semester",
+                "summary": "Delete locked class",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "semester uuid",
+                        "description": "locked class uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1858,7 +1850,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for update semester",
+                "description": "Endpoint for moving a locked class or changing its professor, room or section",
                 "consumes": [
                     "application/json"
                 ],
@@ -1866,23 +1858,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "semester"
+                    "locked class"
                 ],
-                "summary": "Update semester",
+                "summary": "Update locked class",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "semester uuid",
+                        "description": "locked class uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update semester dto",
+                        "description": "Update locked class dto",
                         "name": "body",
                         "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateSemesterDto"
+                            "$ref": "#/definitions/dto.UpdateLockedClassDto"
                         }
                     }
                 ],
@@ -1911,9 +1903,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/parameterization-constraints": {
             "post": {
-                "description": "Endpoint for create user",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for enabling or disabling a registered constraint in a parameterization and setting its weight",
                 "consumes": [
                     "application/json"
                 ],
@@ -1921,23 +1918,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "parameterization constraint"
                 ],
-                "summary": "Create new user",
+                "summary": "Configure a constraint in a parameterization",
                 "parameters": [
                     {
-                        "description": "Create user dto",
+                        "description": "Create parameterization constraint dto",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateUserDto"
+                            "$ref": "#/definitions/dto.CreateParameterizationConstraintDto"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1952,14 +1949,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/parameterization-constraints/available": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for update user",
+                "description": "List the constraints timetables can be scored with, whether they are hard and their default weight",
                 "consumes": [
                     "application/json"
                 ],
@@ -1967,33 +1966,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
-                ],
-                "summary": "Update user",
-                "parameters": [
-                    {
-                        "description": "Update user dto",
-                        "name": "body",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserDto"
-                        }
-                    }
+                    "parameterization constraint"
                 ],
+                "summary": "Get many constraints",
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
+                            "$ref": "#/definitions/response.ManyConstraintsResponse"
                         }
                     },
                     "500": {
@@ -2005,14 +1985,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/list-all": {
+        "/parameterization-constraints/list-all/{parameterizationId}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get many users",
+                "description": "List the constraints a parameterization configures",
                 "consumes": [
                     "application/json"
                 ],
@@ -2020,14 +2000,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "parameterization constraint"
+                ],
+                "summary": "Get many parameterization constraints by parameterization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization id",
+                        "name": "parameterizationId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get many users",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.ManyUsersResponse"
+                            "$ref": "#/definitions/response.ManyParameterizationConstraintsResponse"
                         }
                     },
                     "400": {
@@ -2036,12 +2025,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httperr.RestErr"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httperr.RestErr"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2051,14 +2034,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/password": {
-            "patch": {
+        "/parameterization-constraints/{uuid}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Endpoint for Update user password",
+                "description": "Get parameterization constraint by uuid",
                 "consumes": [
                     "application/json"
                 ],
@@ -2066,23 +2049,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "parameterization constraint"
                 ],
-                "summary": "Update user password",
+                "summary": "Parameterization constraint details",
                 "parameters": [
                     {
-                        "description": "Update user password dto",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserPasswordDto"
-                        }
+                        "type": "string",
+                        "description": "parameterization constraint uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ParameterizationConstraintResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2090,6 +2074,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httperr.RestErr"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2097,16 +2087,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/{uuid}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get user by uuid",
+                "description": "Remove the configuration of a constraint, which goes back to enabled with its default weight",
                 "consumes": [
                     "application/json"
                 ],
@@ -2114,24 +2102,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "parameterization constraint"
                 ],
-                "summary": "User details",
+                "summary": "Delete parameterization constraint",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "user uuid",
+                        "description": "parameterization constraint uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/response.UserResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2153,13 +2138,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete user by uuid",
+                "description": "Endpoint for enabling or disabling a configured constraint or changing its weight, 0 going back to the default weight",
                 "consumes": [
                     "application/json"
                 ],
@@ -2167,16 +2152,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "parameterization constraint"
                 ],
-                "summary": "Delete user",
+                "summary": "Update parameterization constraint",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "user uuid",
+                        "description": "parameterization constraint uuid",
                         "name": "uuid",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update parameterization constraint dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateParameterizationConstraintDto"
+                        }
                     }
                 ],
                 "responses": {
@@ -2203,40 +2196,5149 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.CreateAvailabilityDto": {
-            "type": "object",
-            "required": [
+        },
+        "/parameterization-disciplines": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for choosing a discipline the parameterization should offer, with its priority and mandatory flag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization discipline"
+                ],
+                "summary": "Offer a discipline in a parameterization",
+                "parameters": [
+                    {
+                        "description": "Create parameterization discipline dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateParameterizationDisciplineDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/parameterization-disciplines/list-all/{parameterizationId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the disciplines a parameterization offers, mandatory ones first and then by priority",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization discipline"
+                ],
+                "summary": "Get many parameterization disciplines by parameterization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization id",
+                        "name": "parameterizationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyParameterizationDisciplinesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/parameterization-disciplines/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get parameterization discipline by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization discipline"
+                ],
+                "summary": "Parameterization discipline details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization discipline uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ParameterizationDisciplineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stop offering a discipline in a parameterization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization discipline"
+                ],
+                "summary": "Delete parameterization discipline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization discipline uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for changing the priority or the mandatory flag of an offered discipline",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization discipline"
+                ],
+                "summary": "Update parameterization discipline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization discipline uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update parameterization discipline dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateParameterizationDisciplineDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/parameterizations": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for create parameterization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization"
+                ],
+                "summary": "Create new parameterization",
+                "parameters": [
+                    {
+                        "description": "Create parameterization dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateParameterizationDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/parameterizations/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many parameterization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization"
+                ],
+                "summary": "Get many parameterization",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyParameterizationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/parameterizations/list-all/{semesterId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many parameterizations by semester",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterizations by semester"
+                ],
+                "summary": "Get many parameterizations by semester",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization semesterId",
+                        "name": "semesterId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyParameterizationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/parameterizations/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get parameterization by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization"
+                ],
+                "summary": "Parameterization details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ParameterizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete parameterization by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization"
+                ],
+                "summary": "Delete parameterization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for update parameterization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "parameterization"
+                ],
+                "summary": "Update parameterization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update parameterization dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateParameterizationDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/professors": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for create professor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Create new professor",
+                "parameters": [
+                    {
+                        "description": "Create professor dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateProfessorDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/professors/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many professors",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Get many professors",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProfessorsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/professors/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get professor by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Professor details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "professor uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProfessorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete professor by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Delete professor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "professor uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for update professor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Update professor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "professor uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update professor dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateProfessorDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/professors/{uuid}/timetable/{semesterId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The week of a professor in a semester: their classes in the approved or published proposal of every course, or else its latest one, or only in the approved and published ones, grouped by weekday and shift, with the allocated hours checked against the hours to allocate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Timetable of a professor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "professor uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "semester id",
+                        "name": "semesterId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only the approved and published proposals",
+                        "name": "approved",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProfessorTimetableResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/professors/{uuid}/timetable/{semesterId}/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the week of a professor in a semester as an .xlsx file with a workload summary sheet and a sheet with the classes",
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Export the timetable of a professor to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "professor uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "semester id",
+                        "name": "semesterId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only the approved and published proposals",
+                        "name": "approved",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/professors/{uuid}/timetable/{semesterId}/print": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The week of a professor in a semester as an HTML page with a weekly grid, one column per weekday and one row per class time, laid out to be printed or saved as PDF from the browser",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "professor"
+                ],
+                "summary": "Printable timetable of a professor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "professor uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "semester id",
+                        "name": "semesterId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only the approved and published proposals",
+                        "name": "approved",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposal-jobs/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many proposal jobs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get many proposal jobs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProposalJobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposal-jobs/list-all/{parameterizationId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many proposal jobs by parameterization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get many proposal jobs by parameterization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "parameterization id",
+                        "name": "parameterizationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProposalJobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposal-jobs/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the status, progress and resulting proposal of a generation job",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Proposal job details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal job uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/export/semester/{semesterId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the approved or published proposal of every course in the semester, or else its latest one, as an .xlsx file with a summary sheet and one sheet per course",
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Export semester offering to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "semester id",
+                        "name": "semesterId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many proposals, optionally filtered by semester, course and status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get many proposals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "semester id",
+                        "name": "semester_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "course id",
+                        "name": "course_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "draft, under_review, approved, published or archived",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProposalsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/workload/semester/{semesterId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Compare the hours each professor should teach with the hours allocated in the approved or published proposal of every course in the semester, or else its latest one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Professor workload of a semester",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "semester id",
+                        "name": "semesterId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "list only professors whose allocated hours differ from the planned ones",
+                        "name": "only_mismatches",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProfessorWorkloadsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a generated proposal with its classes, fitness and generation metadata",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Proposal details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProposalDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/calendar": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the classes of the course in the proposal as an RFC 5545 .ics file. Each class is a weekly event from the start to the end of the semester, skipping its holidays, with the discipline as summary and the room as location. Events keep the uuid of their class, so importing the file again updates them",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Export the classes of a proposal to iCalendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/calendar/professor/{professorUuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the classes the professor teaches in the proposal as an RFC 5545 .ics file. Each class is a weekly event from the start to the end of the semester, skipping its holidays, with the discipline as summary and the room as location. Events keep the uuid of their class, so importing the file again updates them",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Export the classes of a professor in a proposal to iCalendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "professor uuid",
+                        "name": "professorUuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/classes": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for adding a class to a generated proposal, while it is a draft or under review. The edit is checked against the same constraints as the generation; when it breaks a hard one it is rejected with 409, unless force is set, in which case it is saved and flagged. Every saved edit is recorded as a new version of the proposal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Add a class to a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create proposal class dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateProposalClassDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalEditResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/classes/{classUuid}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for removing a class from a generated proposal, while it is a draft or under review. The edit is checked against the same constraints as the generation; when it breaks a hard one, such as leaving a section short of hours, it is rejected with 409, unless force is set, in which case it is saved and flagged. Every saved edit is recorded as a new version of the proposal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Remove a class from a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "class uuid",
+                        "name": "classUuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "remove the class even if it breaks a hard constraint",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "why the class is removed, kept in the version history",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalEditResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for moving a class of a generated proposal (day, HH:MM start and end) or changing its professor or room, while the proposal is a draft or under review. The edit is checked against the same constraints as the generation; when it breaks a hard one it is rejected with 409, unless force is set, in which case it is saved and flagged. Every saved edit is recorded as a new version of the proposal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Move or reassign a proposal class",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "class uuid",
+                        "name": "classUuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update proposal class dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateProposalClassDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalEditResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/compare/{otherUuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Diff a proposal against a base one: classes added, removed or whose professor, day, shift, time or room changed, the weekly hours of every professor, the credits offered and the constraint violations of each",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Compare two proposals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "base proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "uuid of the proposal compared with the base one",
+                        "name": "otherUuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the proposal as an .xlsx file with a summary sheet and one sheet for its course",
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Export proposal to Excel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/meetings": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Expand the weekly classes of the proposal into the dated class meetings of the whole semester, skipping its holidays",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Semester meetings of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalMeetingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/transitions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the current status of a proposal and every transition it went through, with who made it, when and why",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Status history of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProposalTransitionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for moving a proposal through its lifecycle, from draft to under_review, approved, published and archived. A proposal under review may go back to draft and an approved one back to review; any proposal but an archived one may be archived. A course has a single approved or published proposal per semester, and approved, published and archived proposals can no longer be edited",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Change the status of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transition proposal dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransitionProposalDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/versions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for listing the history of a proposal, the latest version first. The generated classes are its first version and every edit or restore adds one, with its author, reason and fitness",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "List the versions of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProposalVersionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/versions/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for getting a past version of a proposal, with the classes, fitness and fitness breakdown it had",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get a version of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "version number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/versions/{version}/compare/{otherVersion}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Diff a version of a proposal against a base version of it, as two proposals are compared: classes added, removed or changed, the weekly hours of every professor, the credits offered and the constraint violations of each",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Compare two versions of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "base version number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of the version compared with the base one",
+                        "name": "otherVersion",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/versions/{version}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for bringing back the classes of an older version of a proposal, while it is a draft or under review. The history is kept: the restored classes are saved as a new version",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Restore a version of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "version number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Restore proposal version dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RestoreProposalVersionDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/response.ProposalVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/proposals/{uuid}/workload": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Compare the hours each professor should teach with the hours allocated in the proposal",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Professor workload of a proposal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "proposal uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "list only professors whose allocated hours differ from the planned ones",
+                        "name": "only_mismatches",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyProfessorWorkloadsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for create room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Create new room",
+                "parameters": [
+                    {
+                        "description": "Create room dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateRoomDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many rooms",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Get many rooms",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyRoomsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get room by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Room details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "room uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.RoomResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete room by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Delete room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "room uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for update room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Update room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "room uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update room dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRoomDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/semester-holidays": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for marking a day of the semester (YYYY-MM-DD) as a day without classes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester holiday"
+                ],
+                "summary": "Add a holiday to a semester",
+                "parameters": [
+                    {
+                        "description": "Create semester holiday dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateSemesterHolidayDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/semester-holidays/{uuid}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a holiday from a semester",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester holiday"
+                ],
+                "summary": "Delete semester holiday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "semester holiday uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for moving a semester holiday to another day or changing its description",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester holiday"
+                ],
+                "summary": "Update semester holiday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "semester holiday uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update semester holiday dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateSemesterHolidayDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/semesters": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for create semester, optionally with the first and last days of classes (YYYY-MM-DD)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester"
+                ],
+                "summary": "Create new semester",
+                "parameters": [
+                    {
+                        "description": "Create semester dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateSemesterDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/semesters/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many semesters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester"
+                ],
+                "summary": "Get many semesters",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManySemestersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/semesters/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get semester by uuid, with its holidays",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester"
+                ],
+                "summary": "Semester details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "semester uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.SemesterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete semester by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester"
+                ],
+                "summary": "Delete semester",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "semester uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for update semester",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "semester"
+                ],
+                "summary": "Update semester",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "semester uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update semester dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateSemesterDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift-hours": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the hours of a shift for a course, for the courses at a location or, with neither given, for the whole institution",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift hours"
+                ],
+                "summary": "Create new shift hours",
+                "parameters": [
+                    {
+                        "description": "Create shift hours dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateShiftHoursDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift-hours/course/{courseId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The hours each shift has for a course: its own, the ones of its location, the institution-wide ones or the defaults, whichever is the most specific",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift hours"
+                ],
+                "summary": "Get shift hours of a course",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "course id",
+                        "name": "courseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyShiftHoursResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift-hours/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List every configured shift hours",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift hours"
+                ],
+                "summary": "Get many shift hours",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyShiftHoursResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/shift-hours/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get shift hours by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift hours"
+                ],
+                "summary": "Shift hours details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "shift hours uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ShiftHoursResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete shift hours by uuid, falling back to the less specific hours of the shift",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift hours"
+                ],
+                "summary": "Delete shift hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "shift hours uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for update the start and end of shift hours",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift hours"
+                ],
+                "summary": "Update shift hours",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "shift hours uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update shift hours dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateShiftHoursDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/time-slots": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a period to the time grid classes are scheduled on. Without weekdays the period is given from Monday to Friday",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "time slot"
+                ],
+                "summary": "Create new time slot",
+                "parameters": [
+                    {
+                        "description": "Create time slot dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTimeSlotDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/time-slots/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the time grid, in the order the periods start",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "time slot"
+                ],
+                "summary": "Get many time slots",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyTimeSlotsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/time-slots/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get time slot by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "time slot"
+                ],
+                "summary": "Time slot details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "time slot uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.TimeSlotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete time slot by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "time slot"
+                ],
+                "summary": "Delete time slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "time slot uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for update time slot",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "time slot"
+                ],
+                "summary": "Update time slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "time slot uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update time slot dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTimeSlotDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "post": {
+                "description": "Endpoint for create user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Create new user",
+                "parameters": [
+                    {
+                        "description": "Create user dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for update user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "description": "Update user dto",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/list-all": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get many users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get many users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.ManyUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Endpoint for Update user password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Update user password",
+                "parameters": [
+                    {
+                        "description": "Update user password dto",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserPasswordDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{uuid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get user by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "User details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete user by uuid",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user uuid",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.RestErr"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.ClassDTO": {
+            "type": "object",
+            "properties": {
+                "day_of_week": {
+                    "type": "string"
+                },
+                "discipline": {
+                    "$ref": "#/definitions/dto.DisciplineDTO"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "locked": {
+                    "type": "boolean"
+                },
+                "professor": {
+                    "$ref": "#/definitions/dto.ProfessorDTO"
+                },
+                "proposal_id": {
+                    "type": "integer"
+                },
+                "room": {
+                    "$ref": "#/definitions/dto.RoomDTO"
+                },
+                "section": {
+                    "type": "integer"
+                },
+                "shift": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ConstraintScoreDTO": {
+            "type": "object",
+            "properties": {
+                "hard": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "penalty": {
+                    "type": "number"
+                },
+                "violations": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CreateAvailabilityDto": {
+            "type": "object",
+            "required": [
                 "dayOfWeek",
+                "end_time",
+                "professor_id",
+                "start_time"
+            ],
+            "properties": {
+                "dayOfWeek": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "professor_id": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateCourseDto": {
+            "type": "object",
+            "required": [
+                "location",
+                "modality",
+                "name"
+            ],
+            "properties": {
+                "location": {
+                    "type": "string"
+                },
+                "modality": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.CreateCurriculumMatrixDisciplineDto": {
+            "type": "object",
+            "required": [
+                "curriculum_matrix_id",
+                "discipline_id",
+                "term"
+            ],
+            "properties": {
+                "curriculum_matrix_id": {
+                    "type": "integer"
+                },
+                "discipline_id": {
+                    "type": "integer"
+                },
+                "mandatory": {
+                    "type": "boolean"
+                },
+                "term": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.CreateCurriculumMatrixDto": {
+            "type": "object",
+            "required": [
+                "course_id",
+                "version"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "course_id": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.CreateDisciplineDto": {
+            "type": "object",
+            "required": [
+                "code",
+                "course_id",
+                "credits",
+                "name",
+                "room_type"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "course_id": {
+                    "type": "integer"
+                },
+                "credits": {
+                    "type": "integer"
+                },
+                "expected_enrolment": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "room_type": {
+                    "type": "string",
+                    "enum": [
+                        "lecture",
+                        "lab"
+                    ]
+                },
+                "term": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.CreateEligibleDisciplineDto": {
+            "type": "object",
+            "required": [
+                "discipline_id",
+                "professor_id"
+            ],
+            "properties": {
+                "discipline_id": {
+                    "type": "integer"
+                },
+                "professor_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreateLockedClassDto": {
+            "type": "object",
+            "required": [
+                "day_of_week",
+                "discipline_id",
+                "end_time",
+                "parameterization_id",
+                "professor_id",
+                "start_time"
+            ],
+            "properties": {
+                "day_of_week": {
+                    "type": "string",
+                    "enum": [
+                        "Monday",
+                        "Tuesday",
+                        "Wednesday",
+                        "Thursday",
+                        "Friday",
+                        "Saturday"
+                    ]
+                },
+                "discipline_id": {
+                    "type": "integer"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "parameterization_id": {
+                    "type": "integer"
+                },
+                "professor_id": {
+                    "type": "integer"
+                },
+                "room_id": {
+                    "type": "integer"
+                },
+                "section": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateParameterizationConstraintDto": {
+            "type": "object",
+            "required": [
+                "name",
+                "parameterization_id"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parameterization_id": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "number",
+                    "maximum": 100
+                }
+            }
+        },
+        "dto.CreateParameterizationDisciplineDto": {
+            "type": "object",
+            "required": [
+                "discipline_id",
+                "parameterization_id"
+            ],
+            "properties": {
+                "discipline_id": {
+                    "type": "integer"
+                },
+                "mandatory": {
+                    "type": "boolean"
+                },
+                "parameterization_id": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.CreateParameterizationDto": {
+            "type": "object",
+            "required": [
+                "course_id",
+                "maxCreditsToOffer",
+                "numClassesPerDiscipline",
+                "semester_id"
+            ],
+            "properties": {
+                "course_id": {
+                    "type": "integer"
+                },
+                "generations": {
+                    "type": "integer",
+                    "maximum": 100000,
+                    "minimum": 1
+                },
+                "hoursPerCredit": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1
+                },
+                "idleGapWeight": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "maxBlockHours": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1
+                },
+                "maxCreditsToOffer": {
+                    "type": "integer"
+                },
+                "mutationRate": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "numClassesPerDiscipline": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "populationSize": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 2
+                },
+                "sameDayWeight": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "semester_id": {
+                    "type": "integer"
+                },
+                "spreadWeight": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "tournamentSize": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.CreateProfessorDto": {
+            "type": "object",
+            "required": [
+                "hoursToAllocate",
+                "name"
+            ],
+            "properties": {
+                "hoursToAllocate": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.CreateProposalClassDto": {
+            "type": "object",
+            "required": [
+                "day_of_week",
+                "discipline_id",
+                "end_time",
                 "professor_id",
-                "shift"
+                "start_time"
+            ],
+            "properties": {
+                "day_of_week": {
+                    "type": "string",
+                    "enum": [
+                        "Monday",
+                        "Tuesday",
+                        "Wednesday",
+                        "Thursday",
+                        "Friday",
+                        "Saturday"
+                    ]
+                },
+                "discipline_id": {
+                    "type": "integer"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "force": {
+                    "type": "boolean"
+                },
+                "professor_id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "room_id": {
+                    "type": "integer"
+                },
+                "section": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateRoomDto": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "location",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "lecture",
+                        "lab"
+                    ]
+                }
+            }
+        },
+        "dto.CreateSemesterDto": {
+            "type": "object",
+            "required": [
+                "semester"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "semester": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateSemesterHolidayDto": {
+            "type": "object",
+            "required": [
+                "date",
+                "description",
+                "semester_id"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "semester_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreateShiftHoursDto": {
+            "type": "object",
+            "required": [
+                "end_time",
+                "shift",
+                "start_time"
+            ],
+            "properties": {
+                "course_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "shift": {
+                    "type": "string",
+                    "enum": [
+                        "Morning",
+                        "Afternoon",
+                        "Night"
+                    ]
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateTimeSlotDto": {
+            "type": "object",
+            "required": [
+                "end_time",
+                "label",
+                "shift",
+                "start_time"
+            ],
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1
+                },
+                "shift": {
+                    "type": "string",
+                    "enum": [
+                        "Morning",
+                        "Afternoon",
+                        "Night"
+                    ]
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateUserDto": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 8
+                }
+            }
+        },
+        "dto.DeleteEligibleDisciplineDto": {
+            "type": "object",
+            "required": [
+                "discipline_id",
+                "professor_id"
+            ],
+            "properties": {
+                "discipline_id": {
+                    "type": "integer"
+                },
+                "professor_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.DisciplineDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "course_id": {
+                    "type": "integer"
+                },
+                "credits": {
+                    "type": "integer"
+                },
+                "expected_enrolment": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "room_type": {
+                    "type": "string"
+                },
+                "term": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GenerateProposalDto": {
+            "type": "object",
+            "properties": {
+                "seed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LockProposalClassesDto": {
+            "type": "object",
+            "required": [
+                "parameterization_id"
+            ],
+            "properties": {
+                "class_uuids": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parameterization_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LoginDTO": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 40,
+                    "minLength": 8
+                }
+            }
+        },
+        "dto.ProfessorDTO": {
+            "type": "object",
+            "properties": {
+                "disciplines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DisciplineDTO"
+                    }
+                },
+                "hours_to_allocate": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ProposalDTO": {
+            "type": "object",
+            "properties": {
+                "classes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClassDTO"
+                    }
+                },
+                "course_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "fitness": {
+                    "type": "number"
+                },
+                "fitness_breakdown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ConstraintScoreDTO"
+                    }
+                },
+                "generations": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mutation_rate": {
+                    "type": "number"
+                },
+                "parameterization_id": {
+                    "type": "integer"
+                },
+                "population_size": {
+                    "type": "integer"
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "semester_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tournament_size": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RestoreProposalVersionDto": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "dto.RoomDTO": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TransitionProposalDto": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "under_review",
+                        "approved",
+                        "published",
+                        "archived"
+                    ]
+                }
+            }
+        },
+        "dto.UpdateAvailabilityDto": {
+            "type": "object",
+            "properties": {
+                "dayOfWeek": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateCourseDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "type": "string"
+                },
+                "modality": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.UpdateCurriculumMatrixDisciplineDto": {
+            "type": "object",
+            "properties": {
+                "mandatory": {
+                    "type": "boolean"
+                },
+                "term": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.UpdateCurriculumMatrixDto": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "version": {
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "dto.UpdateDisciplineDto": {
+            "type": "object",
+            "required": [
+                "credits",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "credits": {
+                    "type": "integer"
+                },
+                "expected_enrolment": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "room_type": {
+                    "type": "string",
+                    "enum": [
+                        "lecture",
+                        "lab"
+                    ]
+                },
+                "term": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.UpdateLockedClassDto": {
+            "type": "object",
+            "properties": {
+                "day_of_week": {
+                    "type": "string",
+                    "enum": [
+                        "Monday",
+                        "Tuesday",
+                        "Wednesday",
+                        "Thursday",
+                        "Friday",
+                        "Saturday"
+                    ]
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "professor_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "room_id": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "section": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateParameterizationConstraintDto": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "weight": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.UpdateParameterizationDisciplineDto": {
+            "type": "object",
+            "properties": {
+                "mandatory": {
+                    "type": "boolean"
+                },
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.UpdateParameterizationDto": {
+            "type": "object",
+            "required": [
+                "maxCreditsToOffer",
+                "numClassesPerDiscipline"
+            ],
+            "properties": {
+                "generations": {
+                    "type": "integer",
+                    "maximum": 100000,
+                    "minimum": 1
+                },
+                "hoursPerCredit": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1
+                },
+                "idleGapWeight": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "maxBlockHours": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1
+                },
+                "maxCreditsToOffer": {
+                    "type": "integer"
+                },
+                "mutationRate": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0
+                },
+                "numClassesPerDiscipline": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "populationSize": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 2
+                },
+                "sameDayWeight": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "spreadWeight": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "tournamentSize": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                }
+            }
+        },
+        "dto.UpdateProfessorDto": {
+            "type": "object",
+            "required": [
+                "hoursToAllocate",
+                "name"
+            ],
+            "properties": {
+                "hoursToAllocate": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.UpdateProposalClassDto": {
+            "type": "object",
+            "properties": {
+                "day_of_week": {
+                    "type": "string",
+                    "enum": [
+                        "Monday",
+                        "Tuesday",
+                        "Wednesday",
+                        "Thursday",
+                        "Friday",
+                        "Saturday"
+                    ]
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "force": {
+                    "type": "boolean"
+                },
+                "professor_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "room_id": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateRoomDto": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "lecture",
+                        "lab"
+                    ]
+                }
+            }
+        },
+        "dto.UpdateSemesterDto": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "semester": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateSemesterHolidayDto": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "dto.UpdateShiftHoursDto": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateTimeSlotDto": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1
+                },
+                "shift": {
+                    "type": "string",
+                    "enum": [
+                        "Morning",
+                        "Afternoon",
+                        "Night"
+                    ]
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.UpdateUserDto": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.UpdateUserPasswordDto": {
+            "type": "object",
+            "required": [
+                "old_password",
+                "password"
             ],
             "properties": {
-                "dayOfWeek": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "old_password": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 8
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 8
+                }
+            }
+        },
+        "httperr.Fields": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "value": {}
+            }
+        },
+        "httperr.RestErr": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/httperr.Fields"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.AvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "'id'": {
+                    "type": "integer"
+                },
+                "dayOfWeek": {
+                    "type": "string"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "professor_id": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ClassChangeResponse": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "$ref": "#/definitions/dto.ClassDTO"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "other": {
+                    "$ref": "#/definitions/dto.ClassDTO"
+                }
+            }
+        },
+        "response.ConstraintResponse": {
+            "type": "object",
+            "properties": {
+                "default_weight": {
+                    "type": "number"
                 },
-                "professor_id": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "shift": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "hard": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateCourseDto": {
+        "response.CourseResponse": {
             "type": "object",
-            "required": [
-                "location",
-                "modality",
-                "name"
-            ],
             "properties": {
+                "id": {
+                    "type": "integer"
+                },
                 "location": {
                     "type": "string"
                 },
@@ -2244,464 +7346,892 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateDisciplineDto": {
+        "response.CurriculumMatrixDisciplineResponse": {
+            "type": "object",
+            "properties": {
+                "credits": {
+                    "type": "integer"
+                },
+                "curriculum_matrix_id": {
+                    "type": "integer"
+                },
+                "discipline_code": {
+                    "type": "string"
+                },
+                "discipline_id": {
+                    "type": "integer"
+                },
+                "discipline_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mandatory": {
+                    "type": "boolean"
+                },
+                "term": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CurriculumMatrixResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "course_id": {
+                    "type": "integer"
+                },
+                "disciplines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CurriculumMatrixDisciplineResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.DisciplineResponse": {
             "type": "object",
-            "required": [
-                "course_id",
-                "credits",
-                "name"
-            ],
             "properties": {
+                "code": {
+                    "type": "string"
+                },
                 "course_id": {
                     "type": "integer"
                 },
                 "credits": {
                     "type": "integer"
                 },
+                "expected_enrolment": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                    "type": "string"
+                },
+                "room_type": {
+                    "type": "string"
+                },
+                "term": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateEligibleDisciplineDto": {
+        "response.LockedClassResponse": {
             "type": "object",
-            "required": [
-                "discipline_id",
-                "professor_id"
-            ],
             "properties": {
+                "day_of_week": {
+                    "type": "string"
+                },
                 "discipline_id": {
                     "type": "integer"
                 },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "parameterization_id": {
+                    "type": "integer"
+                },
                 "professor_id": {
                     "type": "integer"
+                },
+                "room_id": {
+                    "type": "integer"
+                },
+                "section": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateParameterizationDto": {
+        "response.ManyAvailabilitiesResponse": {
+            "type": "object",
+            "properties": {
+                "availabilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.AvailabilityResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyConstraintsResponse": {
+            "type": "object",
+            "properties": {
+                "constraints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ConstraintResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyCoursesResponse": {
+            "type": "object",
+            "properties": {
+                "courses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CourseResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyCurriculumMatricesResponse": {
+            "type": "object",
+            "properties": {
+                "curriculum_matrices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CurriculumMatrixResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyDisciplinesResponse": {
+            "type": "object",
+            "properties": {
+                "disciplines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DisciplineResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyLockedClassesResponse": {
+            "type": "object",
+            "properties": {
+                "locked_classes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LockedClassResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyParameterizationConstraintsResponse": {
+            "type": "object",
+            "properties": {
+                "parameterization_constraints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ParameterizationConstraintResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyParameterizationDisciplinesResponse": {
+            "type": "object",
+            "properties": {
+                "parameterization_disciplines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ParameterizationDisciplineResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyParameterizationsResponse": {
+            "type": "object",
+            "properties": {
+                "parameterizations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ParameterizationResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyProfessorWorkloadsResponse": {
+            "type": "object",
+            "properties": {
+                "workloads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProfessorWorkloadResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyProfessorsResponse": {
+            "type": "object",
+            "properties": {
+                "professors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProfessorResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyProposalJobsResponse": {
+            "type": "object",
+            "properties": {
+                "proposal_jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProposalJobResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyProposalTransitionsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                },
+                "transitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProposalTransitionResponse"
+                    }
+                }
+            }
+        },
+        "response.ManyProposalVersionsResponse": {
             "type": "object",
-            "required": [
-                "course_id",
-                "maxCreditsToOffer",
-                "numClassesPerDiscipline",
-                "semester_id"
-            ],
             "properties": {
-                "course_id": {
-                    "type": "integer"
-                },
-                "maxCreditsToOffer": {
-                    "type": "integer"
-                },
-                "numClassesPerDiscipline": {
-                    "type": "integer"
-                },
-                "semester_id": {
-                    "type": "integer"
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProposalVersionResponse"
+                    }
                 }
             }
         },
-        "dto.CreateProfessorDto": {
+        "response.ManyProposalsResponse": {
             "type": "object",
-            "required": [
-                "hoursToAllocate",
-                "name"
-            ],
             "properties": {
-                "hoursToAllocate": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "proposals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProposalDTO"
+                    }
                 }
             }
         },
-        "dto.CreateSemesterDto": {
+        "response.ManyRoomsResponse": {
             "type": "object",
-            "required": [
-                "semester"
-            ],
             "properties": {
-                "semester": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RoomResponse"
+                    }
                 }
             }
         },
-        "dto.CreateUserDto": {
+        "response.ManySemestersResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 30,
-                    "minLength": 8
+                "semesters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.SemesterResponse"
+                    }
                 }
             }
         },
-        "dto.DeleteEligibleDisciplineDto": {
+        "response.ManyShiftHoursResponse": {
             "type": "object",
-            "required": [
-                "discipline_id",
-                "professor_id"
-            ],
             "properties": {
-                "discipline_id": {
-                    "type": "integer"
-                },
-                "professor_id": {
-                    "type": "integer"
+                "shift_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ShiftHoursResponse"
+                    }
                 }
             }
         },
-        "dto.LoginDTO": {
+        "response.ManyTimeSlotsResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 40,
-                    "minLength": 8
+                "time_slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.TimeSlotResponse"
+                    }
                 }
             }
         },
-        "dto.UpdateAvailabilityDto": {
+        "response.ManyUsersResponse": {
             "type": "object",
-            "required": [
-                "dayOfWeek",
-                "shift"
-            ],
             "properties": {
-                "dayOfWeek": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
-                },
-                "shift": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.UserResponse"
+                    }
                 }
             }
         },
-        "dto.UpdateCourseDto": {
+        "response.ParameterizationConstraintResponse": {
             "type": "object",
             "properties": {
-                "location": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "modality": {
+                "parameterization_id": {
+                    "type": "integer"
+                },
+                "uuid": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "weight": {
+                    "type": "number"
                 }
             }
         },
-        "dto.UpdateDisciplineDto": {
+        "response.ParameterizationDisciplineResponse": {
             "type": "object",
-            "required": [
-                "credits",
-                "name"
-            ],
             "properties": {
-                "credits": {
+                "discipline_id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "id": {
+                    "type": "integer"
+                },
+                "mandatory": {
+                    "type": "boolean"
+                },
+                "parameterization_id": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.UpdateParameterizationDto": {
+        "response.ParameterizationResponse": {
             "type": "object",
-            "required": [
-                "maxCreditsToOffer",
-                "numClassesPerDiscipline"
-            ],
             "properties": {
+                "course_id": {
+                    "type": "integer"
+                },
+                "generations": {
+                    "type": "integer"
+                },
+                "hoursPerCredit": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "idleGapWeight": {
+                    "type": "number"
+                },
+                "maxBlockHours": {
+                    "type": "integer"
+                },
                 "maxCreditsToOffer": {
                     "type": "integer"
                 },
+                "mutationRate": {
+                    "type": "number"
+                },
                 "numClassesPerDiscipline": {
                     "type": "integer"
+                },
+                "populationSize": {
+                    "type": "integer"
+                },
+                "sameDayWeight": {
+                    "type": "number"
+                },
+                "semester_id": {
+                    "type": "integer"
+                },
+                "spreadWeight": {
+                    "type": "number"
+                },
+                "tournamentSize": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.UpdateProfessorDto": {
+        "response.ProfessorHoursDeltaResponse": {
             "type": "object",
-            "required": [
-                "hoursToAllocate",
-                "name"
-            ],
             "properties": {
-                "hoursToAllocate": {
-                    "type": "integer"
+                "base_hours": {
+                    "type": "number"
+                },
+                "delta": {
+                    "type": "number"
                 },
                 "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                    "type": "string"
+                },
+                "other_hours": {
+                    "type": "number"
+                },
+                "professor_id": {
+                    "type": "integer"
+                },
+                "professor_uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.UpdateSemesterDto": {
+        "response.ProfessorResponse": {
             "type": "object",
             "properties": {
-                "semester": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "hoursToAllocate": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.UpdateUserDto": {
+        "response.ProfessorTimetableClassResponse": {
             "type": "object",
             "properties": {
-                "email": {
+                "class": {
+                    "$ref": "#/definitions/dto.ClassDTO"
+                },
+                "course_name": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "proposal_uuid": {
+                    "type": "string"
                 }
             }
         },
-        "dto.UpdateUserPasswordDto": {
+        "response.ProfessorTimetableDayResponse": {
             "type": "object",
-            "required": [
-                "old_password",
-                "password"
-            ],
             "properties": {
-                "old_password": {
-                    "type": "string",
-                    "maxLength": 30,
-                    "minLength": 8
+                "day_of_week": {
+                    "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "maxLength": 30,
-                    "minLength": 8
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProfessorTimetableShiftResponse"
+                    }
                 }
             }
         },
-        "httperr.Fields": {
+        "response.ProfessorTimetableResponse": {
             "type": "object",
             "properties": {
-                "field": {
+                "allocated_hours": {
+                    "type": "number"
+                },
+                "approved_only": {
+                    "type": "boolean"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProfessorTimetableDayResponse"
+                    }
+                },
+                "delta": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "planned_hours": {
+                    "type": "integer"
+                },
+                "professor_uuid": {
                     "type": "string"
                 },
-                "message": {
+                "semester_id": {
+                    "type": "integer"
+                },
+                "semester_name": {
                     "type": "string"
                 },
-                "value": {}
+                "status": {
+                    "type": "string"
+                }
             }
         },
-        "httperr.RestErr": {
+        "response.ProfessorTimetableShiftResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer"
-                },
-                "error": {
-                    "type": "string"
-                },
-                "fields": {
+                "classes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/httperr.Fields"
+                        "$ref": "#/definitions/response.ProfessorTimetableClassResponse"
                     }
                 },
-                "message": {
+                "shift": {
                     "type": "string"
                 }
             }
         },
-        "response.AvailabilityResponse": {
+        "response.ProfessorWorkloadResponse": {
             "type": "object",
             "properties": {
-                "'id'": {
+                "allocated_hours": {
+                    "type": "number"
+                },
+                "classes": {
                     "type": "integer"
                 },
-                "dayOfWeek": {
+                "delta": {
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
                 },
+                "planned_hours": {
+                    "type": "integer"
+                },
                 "professor_id": {
                     "type": "integer"
                 },
-                "shift": {
+                "professor_uuid": {
                     "type": "string"
                 },
-                "uuid": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "response.CourseResponse": {
+        "response.ProposalDiffResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "added_classes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClassDTO"
+                    }
                 },
-                "location": {
-                    "type": "string"
+                "base": {
+                    "$ref": "#/definitions/response.ProposalDiffSummaryResponse"
                 },
-                "modality": {
-                    "type": "string"
+                "changed_classes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ClassChangeResponse"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "other": {
+                    "$ref": "#/definitions/response.ProposalDiffSummaryResponse"
                 },
-                "uuid": {
-                    "type": "string"
+                "professor_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProfessorHoursDeltaResponse"
+                    }
+                },
+                "removed_classes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClassDTO"
+                    }
                 }
             }
         },
-        "response.DisciplineResponse": {
+        "response.ProposalDiffSummaryResponse": {
             "type": "object",
             "properties": {
-                "course_id": {
+                "classes": {
                     "type": "integer"
                 },
                 "credits": {
                     "type": "integer"
                 },
-                "id": {
+                "fitness": {
+                    "type": "number"
+                },
+                "fitness_breakdown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ConstraintScoreDTO"
+                    }
+                },
+                "hard_violations": {
                     "type": "integer"
                 },
-                "name": {
+                "proposal_uuid": {
                     "type": "string"
                 },
-                "uuid": {
-                    "type": "string"
+                "soft_violations": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "response.ManyAvailabilitiesResponse": {
+        "response.ProposalEditResponse": {
             "type": "object",
             "properties": {
-                "availabilities": {
+                "applied": {
+                    "type": "boolean"
+                },
+                "broken_constraints": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.AvailabilityResponse"
+                        "type": "string"
                     }
-                }
-            }
-        },
-        "response.ManyCoursesResponse": {
-            "type": "object",
-            "properties": {
-                "courses": {
+                },
+                "class_uuid": {
+                    "type": "string"
+                },
+                "fitness": {
+                    "type": "number"
+                },
+                "fitness_breakdown": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.CourseResponse"
+                        "$ref": "#/definitions/dto.ConstraintScoreDTO"
+                    }
+                },
+                "forced": {
+                    "type": "boolean"
+                },
+                "proposal_uuid": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ProposalViolationResponse"
                     }
                 }
             }
         },
-        "response.ManyDisciplinesResponse": {
+        "response.ProposalJobResponse": {
             "type": "object",
             "properties": {
-                "disciplines": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.DisciplineResponse"
-                    }
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "parameterization_uuid": {
+                    "type": "string"
+                },
+                "progress": {
+                    "type": "integer"
+                },
+                "proposal_uuid": {
+                    "type": "string"
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "response.ManyParameterizationsResponse": {
+        "response.ProposalMeetingsResponse": {
             "type": "object",
             "properties": {
-                "parameterizations": {
+                "end_date": {
+                    "type": "string"
+                },
+                "meetings": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.ParameterizationResponse"
+                        "$ref": "#/definitions/dto.ClassDTO"
                     }
+                },
+                "proposal_uuid": {
+                    "type": "string"
+                },
+                "semester_id": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
                 }
             }
         },
-        "response.ManyProfessorsResponse": {
+        "response.ProposalTransitionResponse": {
             "type": "object",
             "properties": {
-                "professors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.ProfessorResponse"
-                    }
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "user_uuid": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
-        "response.ManySemestersResponse": {
+        "response.ProposalVersionResponse": {
             "type": "object",
             "properties": {
-                "semesters": {
+                "classes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.SemesterResponse"
+                        "$ref": "#/definitions/dto.ClassDTO"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "fitness": {
+                    "type": "number"
+                },
+                "fitness_breakdown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ConstraintScoreDTO"
                     }
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                },
+                "user_uuid": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "response.ManyUsersResponse": {
+        "response.ProposalViolationResponse": {
             "type": "object",
             "properties": {
-                "users": {
+                "class_uuids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.UserResponse"
+                        "type": "string"
                     }
+                },
+                "constraint": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "hard": {
+                    "type": "boolean"
                 }
             }
         },
-        "response.ParameterizationResponse": {
+        "response.RoomResponse": {
             "type": "object",
             "properties": {
-                "course_id": {
+                "capacity": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "maxCreditsToOffer": {
-                    "type": "integer"
+                "location": {
+                    "type": "string"
                 },
-                "numClassesPerDiscipline": {
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.SemesterHolidayResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
                 "semester_id": {
@@ -2712,16 +8242,51 @@ const docTemplate = `{
                 }
             }
         },
-        "response.ProfessorResponse": {
+        "response.SemesterResponse": {
             "type": "object",
             "properties": {
-                "hoursToAllocate": {
+                "end_date": {
+                    "type": "string"
+                },
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.SemesterHolidayResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "semester": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ShiftHoursResponse": {
+            "type": "object",
+            "properties": {
+                "course_id": {
                     "type": "integer"
                 },
+                "end_time": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "location": {
+                    "type": "string"
+                },
+                "shift": {
+                    "type": "string"
+                },
+                "start_time": {
                     "type": "string"
                 },
                 "uuid": {
@@ -2729,17 +8294,32 @@ const docTemplate = `{
                 }
             }
         },
-        "response.SemesterResponse": {
+        "response.TimeSlotResponse": {
             "type": "object",
             "properties": {
+                "end_time": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "semester": {
+                "label": {
+                    "type": "string"
+                },
+                "shift": {
+                    "type": "string"
+                },
+                "start_time": {
                     "type": "string"
                 },
                 "uuid": {
                     "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -0,0 +1,112 @@
+// Package ics writes minimal RFC 5545 iCalendar files, enough to publish weekly
+// classes as recurring events in calendar applications.
+package ics
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineLength is the length in octets a content line is folded at.
+const maxLineLength = 75
+
+// localLayout writes a date with local time. Times carry no time zone, so calendar
+// applications show them in the zone of the user, the zone the classes were planned in.
+const localLayout = "20060102T150405"
+
+const utcLayout = "20060102T150405Z"
+
+type Calendar struct {
+	productID string
+	name      string
+	events    []Event
+}
+
+// Event is a meeting, repeated every week until Until when it is set, except on
+// ExDates, the starts of the meetings left out.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	Until       time.Time
+	ExDates     []time.Time
+}
+
+func NewCalendar(productID, name string) *Calendar {
+	return &Calendar{productID: productID, name: name}
+}
+
+func (c *Calendar) AddEvent(event Event) {
+	c.events = append(c.events, event)
+}
+
+func (c *Calendar) Write(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	stamp := time.Now().UTC().Format(utcLayout)
+
+	writeLine(w, "BEGIN:VCALENDAR")
+	writeLine(w, "VERSION:2.0")
+	writeLine(w, "PRODID:"+c.productID)
+	writeLine(w, "CALSCALE:GREGORIAN")
+	writeLine(w, "METHOD:PUBLISH")
+	if c.name != "" {
+		writeLine(w, "X-WR-CALNAME:"+escapeText(c.name))
+	}
+	for _, event := range c.events {
+		writeLine(w, "BEGIN:VEVENT")
+		writeLine(w, "UID:"+event.UID)
+		writeLine(w, "DTSTAMP:"+stamp)
+		writeLine(w, "DTSTART:"+event.Start.Format(localLayout))
+		writeLine(w, "DTEND:"+event.End.Format(localLayout))
+		if !event.Until.IsZero() {
+			writeLine(w, "RRULE:FREQ=WEEKLY;UNTIL="+event.Until.Format(localLayout))
+		}
+		if len(event.ExDates) > 0 {
+			dates := make([]string, len(event.ExDates))
+			for i, date := range event.ExDates {
+				dates[i] = date.Format(localLayout)
+			}
+			writeLine(w, "EXDATE:"+strings.Join(dates, ","))
+		}
+		writeLine(w, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(w, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.Location != "" {
+			writeLine(w, "LOCATION:"+escapeText(event.Location))
+		}
+		writeLine(w, "END:VEVENT")
+	}
+	writeLine(w, "END:VCALENDAR")
+	return w.Flush()
+}
+
+// writeLine ends the line with CRLF, folding it so no line is longer than 75 octets
+// and no character is split across lines.
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space of a continuation line counts in its length
+		limit = maxLineLength - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// escapeText escapes the characters with a meaning in TEXT values.
+func escapeText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
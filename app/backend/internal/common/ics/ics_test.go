@@ -0,0 +1,195 @@
+package ics
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "short line",
+			line: "SUMMARY:Algorithms",
+			want: "SUMMARY:Algorithms\r\n",
+		},
+		{
+			name: "exactly 75 octets",
+			line: strings.Repeat("a", 75),
+			want: strings.Repeat("a", 75) + "\r\n",
+		},
+		{
+			name: "folded at 75 octets",
+			line: strings.Repeat("a", 80),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 5) + "\r\n",
+		},
+		{
+			name: "continuation lines count their leading space",
+			line: strings.Repeat("a", 75+74+1),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n",
+		},
+		{
+			name: "multibyte character is not split",
+			line: strings.Repeat("a", 74) + "é",
+			want: strings.Repeat("a", 74) + "\r\n é\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := bufio.NewWriter(&buf)
+			writeLine(w, tt.line)
+			w.Flush()
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writeLine(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+			for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+				if len(line) > maxLineLength {
+					t.Errorf("line of %d octets is longer than %d", len(line), maxLineLength)
+				}
+			}
+		})
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plain", text: "Algorithms", want: "Algorithms"},
+		{name: "comma", text: "Room 1, Block A", want: `Room 1\, Block A`},
+		{name: "semicolon", text: "a;b", want: `a\;b`},
+		{name: "backslash", text: `a\b`, want: `a\\b`},
+		{name: "newline", text: "Course: CS\nProfessor: Ada", want: `Course: CS\nProfessor: Ada`},
+		{name: "crlf", text: "a\r\nb", want: `a\nb`},
+		{name: "backslash before comma", text: `\,`, want: `\\\,`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeText(tt.text); got != tt.want {
+				t.Errorf("escapeText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarWrite(t *testing.T) {
+	start := time.Date(2024, 3, 4, 19, 0, 0, 0, time.UTC)
+	local := time.FixedZone("-03", -3*60*60)
+	tests := []struct {
+		name  string
+		event Event
+		want  []string
+		skip  []string
+	}{
+		{
+			name: "single meeting",
+			event: Event{
+				UID:     "1@time-table-project",
+				Summary: "ALG Algorithms (A)",
+				Start:   start,
+				End:     start.Add(2 * time.Hour),
+			},
+			want: []string{
+				"UID:1@time-table-project",
+				"DTSTART:20240304T190000",
+				"DTEND:20240304T210000",
+				"SUMMARY:ALG Algorithms (A)",
+			},
+			skip: []string{"RRULE:", "EXDATE:", "DESCRIPTION:", "LOCATION:"},
+		},
+		{
+			name: "weekly until the end of the semester skipping holidays",
+			event: Event{
+				UID:         "2@time-table-project",
+				Summary:     "DB Databases",
+				Description: "Course: CS\nProfessor: Ada",
+				Location:    "Lab 1, Block B",
+				Start:       start,
+				End:         start.Add(2 * time.Hour),
+				Until:       time.Date(2024, 6, 24, 19, 0, 0, 0, time.UTC),
+				ExDates: []time.Time{
+					time.Date(2024, 4, 1, 19, 0, 0, 0, time.UTC),
+					time.Date(2024, 5, 20, 19, 0, 0, 0, time.UTC),
+				},
+			},
+			want: []string{
+				"DTSTART:20240304T190000",
+				"RRULE:FREQ=WEEKLY;UNTIL=20240624T190000",
+				"EXDATE:20240401T190000,20240520T190000",
+				`DESCRIPTION:Course: CS\nProfessor: Ada`,
+				`LOCATION:Lab 1\, Block B`,
+			},
+		},
+		{
+			name: "times in a zone are written as its wall clock",
+			event: Event{
+				UID:     "3@time-table-project",
+				Summary: "NET Networks",
+				Start:   time.Date(2024, 3, 5, 8, 0, 0, 0, local),
+				End:     time.Date(2024, 3, 5, 10, 0, 0, 0, local),
+				Until:   time.Date(2024, 6, 25, 8, 0, 0, 0, local),
+				ExDates: []time.Time{time.Date(2024, 4, 2, 8, 0, 0, 0, local)},
+			},
+			want: []string{
+				"DTSTART:20240305T080000",
+				"DTEND:20240305T100000",
+				"RRULE:FREQ=WEEKLY;UNTIL=20240625T080000",
+				"EXDATE:20240402T080000",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar := NewCalendar("-//test//EN", "CS; 2024/1")
+			calendar.AddEvent(tt.event)
+
+			var buf bytes.Buffer
+			if err := calendar.Write(&buf); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			out := buf.String()
+
+			if !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+				t.Errorf("calendar does not end with END:VCALENDAR and CRLF")
+			}
+			if strings.Contains(strings.ReplaceAll(out, "\r\n", ""), "\n") {
+				t.Errorf("calendar has a line not ended with CRLF")
+			}
+
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			for _, want := range append([]string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//EN", `X-WR-CALNAME:CS\; 2024/1`, "BEGIN:VEVENT", "END:VEVENT"}, tt.want...) {
+				if !containsLine(lines, want) {
+					t.Errorf("calendar has no line %q:\n%s", want, out)
+				}
+			}
+			for _, prefix := range tt.skip {
+				for _, line := range lines {
+					if strings.HasPrefix(line, prefix) {
+						t.Errorf("calendar has unexpected line %q", line)
+					}
+				}
+			}
+		})
+	}
+}
+
+func containsLine(lines []string, want string) bool {
+	for _, line := range lines {
+		if line == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+// Package xlsx writes minimal Office Open XML spreadsheets, enough to export
+// tabular reports without depending on an external service or library.
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const maxSheetNameLength = 31
+
+type Workbook struct {
+	sheets []*Sheet
+}
+
+type Sheet struct {
+	name string
+	rows []row
+}
+
+type row struct {
+	header bool
+	cells  []interface{}
+}
+
+func NewWorkbook() *Workbook {
+	return &Workbook{}
+}
+
+// AddSheet appends a sheet to the workbook. The name is cleaned of the characters
+// Excel refuses, truncated to 31 characters and made unique within the workbook.
+func (w *Workbook) AddSheet(name string) *Sheet {
+	name = w.uniqueName(sanitizeSheetName(name))
+	sheet := &Sheet{name: name}
+	w.sheets = append(w.sheets, sheet)
+	return sheet
+}
+
+// AddHeader appends a row rendered in bold.
+func (s *Sheet) AddHeader(values ...string) {
+	cells := make([]interface{}, len(values))
+	for i, v := range values {
+		cells[i] = v
+	}
+	s.rows = append(s.rows, row{header: true, cells: cells})
+}
+
+// AddRow appends a row. Integers and floats are written as numbers, nil as an
+// empty cell and everything else as text.
+func (s *Sheet) AddRow(values ...interface{}) {
+	s.rows = append(s.rows, row{cells: values})
+}
+
+func (w *Workbook) Write(out io.Writer) error {
+	if len(w.sheets) == 0 {
+		w.AddSheet("Sheet1")
+	}
+
+	zw := zip.NewWriter(out)
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{"[Content_Types].xml", w.contentTypes()},
+		{"_rels/.rels", []byte(rootRels)},
+		{"xl/workbook.xml", w.workbook()},
+		{"xl/_rels/workbook.xml.rels", w.workbookRels()},
+		{"xl/styles.xml", []byte(styles)},
+	}
+	for i, sheet := range w.sheets {
+		content, err := sheet.xml()
+		if err != nil {
+			return err
+		}
+		files = append(files, struct {
+			name    string
+			content []byte
+		}{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), content})
+	}
+
+	for _, file := range files {
+		f, err := zw.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(file.content); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func (w *Workbook) uniqueName(name string) string {
+	candidate := name
+	for i := 2; w.hasSheet(candidate); i++ {
+		suffix := fmt.Sprintf(" (%d)", i)
+		base := name
+		if len([]rune(base))+len(suffix) > maxSheetNameLength {
+			base = string([]rune(base)[:maxSheetNameLength-len(suffix)])
+		}
+		candidate = base + suffix
+	}
+	return candidate
+}
+
+func (w *Workbook) hasSheet(name string) bool {
+	for _, sheet := range w.sheets {
+		if strings.EqualFold(sheet.name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func sanitizeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '[', ']', ':', '*', '?', '/', '\\':
+			return '-'
+		}
+		return r
+	}, name)
+	name = strings.Trim(strings.TrimSpace(name), "'")
+	if name == "" {
+		name = "Sheet"
+	}
+	if runes := []rune(name); len(runes) > maxSheetNameLength {
+		name = string(runes[:maxSheetNameLength])
+	}
+	return name
+}
+
+func (w *Workbook) contentTypes() []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range w.sheets {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	b.WriteString(`</Types>`)
+	return b.Bytes()
+}
+
+func (w *Workbook) workbook() []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, sheet := range w.sheets {
+		b.WriteString(`<sheet name="`)
+		xml.EscapeText(&b, []byte(sheet.name))
+		fmt.Fprintf(&b, `" sheetId="%d" r:id="rId%d"/>`, i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.Bytes()
+}
+
+func (w *Workbook) workbookRels() []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range w.sheets {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(w.sheets)+1)
+	b.WriteString(`</Relationships>`)
+	return b.Bytes()
+}
+
+func (s *Sheet) xml() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for r, row := range s.rows {
+		fmt.Fprintf(&b, `<row r="%d">`, r+1)
+		for c, value := range row.cells {
+			if value == nil {
+				continue
+			}
+			ref := cellName(c) + strconv.Itoa(r+1)
+			style := ""
+			if row.header {
+				style = ` s="1"`
+			}
+			if number, ok := numberValue(value); ok {
+				fmt.Fprintf(&b, `<c r="%s"%s><v>%s</v></c>`, ref, style, number)
+				continue
+			}
+			fmt.Fprintf(&b, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">`, ref, style)
+			if err := xml.EscapeText(&b, []byte(fmt.Sprint(value))); err != nil {
+				return nil, err
+			}
+			b.WriteString(`</t></is></c>`)
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.Bytes(), nil
+}
+
+func numberValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case int:
+		return strconv.Itoa(v), true
+	case int32:
+		return strconv.FormatInt(int64(v), 10), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// cellName converts a zero based column index into its letter reference (0 -> A, 26 -> AA).
+func cellName(column int) string {
+	name := ""
+	for column >= 0 {
+		name = string(rune('A'+column%26)) + name
+		column = column/26 - 1
+	}
+	return name
+}
+
+const rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const styles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`</styleSheet>`
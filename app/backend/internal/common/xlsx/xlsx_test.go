@@ -0,0 +1,162 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+)
+
+type worksheetXML struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string `xml:"r,attr"`
+			Type   string `xml:"t,attr"`
+			Style  string `xml:"s,attr"`
+			Value  string `xml:"v"`
+			Inline string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+type workbookXML struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type cell struct {
+	text   bool
+	value  string
+	header bool
+}
+
+func TestWorkbookWrite(t *testing.T) {
+	workbook := NewWorkbook()
+	sheet := workbook.AddSheet("Offer 2024/1")
+	sheet.AddHeader("Code", "Discipline", "Credits", "Hours")
+	sheet.AddRow("ALG", `Algorithms & Data <Structures> "I"`, 4, 3.5)
+	sheet.AddRow("DB", nil, int32(2), int64(40))
+	workbook.AddSheet("Offer 2024/1")
+
+	var buf bytes.Buffer
+	if err := workbook.Write(&buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("workbook is not a zip file: %v", err)
+	}
+	parts := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		parts[f.Name] = content
+	}
+
+	for _, name := range []string{
+		"[Content_Types].xml",
+		"_rels/.rels",
+		"xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels",
+		"xl/styles.xml",
+		"xl/worksheets/sheet1.xml",
+		"xl/worksheets/sheet2.xml",
+	} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("workbook has no part %s", name)
+		}
+	}
+	if !bytes.Contains(parts["[Content_Types].xml"], []byte(`PartName="/xl/worksheets/sheet2.xml"`)) {
+		t.Errorf("content types do not list the second sheet")
+	}
+
+	var wb workbookXML
+	if err := xml.Unmarshal(parts["xl/workbook.xml"], &wb); err != nil {
+		t.Fatalf("parse workbook: %v", err)
+	}
+	wantNames := []string{"Offer 2024-1", "Offer 2024-1 (2)"}
+	if len(wb.Sheets) != len(wantNames) {
+		t.Fatalf("workbook has %d sheets, want %d", len(wb.Sheets), len(wantNames))
+	}
+	for i, want := range wantNames {
+		if wb.Sheets[i].Name != want {
+			t.Errorf("sheet %d name = %q, want %q", i+1, wb.Sheets[i].Name, want)
+		}
+	}
+
+	var ws worksheetXML
+	if err := xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &ws); err != nil {
+		t.Fatalf("parse sheet: %v", err)
+	}
+	cells := make(map[string]cell)
+	for _, row := range ws.Rows {
+		for _, c := range row.Cells {
+			if c.Type == "inlineStr" {
+				cells[c.Ref] = cell{text: true, value: c.Inline, header: c.Style == "1"}
+			} else {
+				cells[c.Ref] = cell{value: c.Value, header: c.Style == "1"}
+			}
+		}
+	}
+
+	tests := []struct {
+		ref  string
+		want cell
+	}{
+		{"A1", cell{text: true, value: "Code", header: true}},
+		{"D1", cell{text: true, value: "Hours", header: true}},
+		{"A2", cell{text: true, value: "ALG"}},
+		{"B2", cell{text: true, value: `Algorithms & Data <Structures> "I"`}},
+		{"C2", cell{value: "4"}},
+		{"D2", cell{value: "3.5"}},
+		{"C3", cell{value: "2"}},
+		{"D3", cell{value: "40"}},
+	}
+	for _, tt := range tests {
+		got, ok := cells[tt.ref]
+		if !ok {
+			t.Errorf("cell %s is missing", tt.ref)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("cell %s = %+v, want %+v", tt.ref, got, tt.want)
+		}
+	}
+	if _, ok := cells["B3"]; ok {
+		t.Errorf("cell B3 is written for a nil value")
+	}
+	if len(ws.Rows) != 3 {
+		t.Errorf("sheet has %d rows, want 3", len(ws.Rows))
+	}
+}
+
+func TestCellName(t *testing.T) {
+	tests := []struct {
+		column int
+		want   string
+	}{
+		{0, "A"},
+		{25, "Z"},
+		{26, "AA"},
+		{51, "AZ"},
+		{52, "BA"},
+		{701, "ZZ"},
+		{702, "AAA"},
+	}
+
+	for _, tt := range tests {
+		if got := cellName(tt.column); got != tt.want {
+			t.Errorf("cellName(%d) = %q, want %q", tt.column, got, tt.want)
+		}
+	}
+}
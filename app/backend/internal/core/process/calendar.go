@@ -0,0 +1,95 @@
+package process
+
+import (
+	"sort"
+	"time"
+
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+// referenceWeek is the week classes are dated in when the semester has no calendar.
+var referenceWeek = time.Date(2024, 10, 7, 0, 0, 0, 0, time.UTC)
+
+// FirstWeek is the Monday of the week the semester of the parameterization starts,
+// the week the generated classes are dated in.
+func FirstWeek(parameterization entity.ParameterizationEntity) time.Time {
+	if parameterization.SemesterStart.IsZero() {
+		return referenceWeek
+	}
+	return weekOf(parameterization.SemesterStart.UTC())
+}
+
+// SemesterMeetings repeats the weekly classes of a proposal on every week of the
+// semester, from its start to its end date, leaving out the holidays. Each meeting
+// keeps the data of its class, dated on the day it takes place.
+func SemesterMeetings(classes []entity.ClassEntity, semester entity.SemesterEntity) []entity.ClassEntity {
+	holidays := make(map[string]bool)
+	for _, holiday := range semester.Holidays {
+		holidays[holiday.Date.Format(time.DateOnly)] = true
+	}
+
+	first, last := dateOf(semester.StartDate.UTC()), dateOf(semester.EndDate.UTC())
+	var meetings []entity.ClassEntity
+	for week := weekOf(first); !week.After(last); week = week.AddDate(0, 0, 7) {
+		for _, class := range classes {
+			day, ok := dayOfWeekDate(week, class.DayOfWeek)
+			if !ok || day.Before(first) || day.After(last) || holidays[day.Format(time.DateOnly)] {
+				continue
+			}
+
+			meeting := class
+			meeting.StartTime = day.Add(clockOffset(class.StartTime))
+			meeting.EndTime = meeting.StartTime.Add(class.EndTime.Sub(class.StartTime))
+			meetings = append(meetings, meeting)
+		}
+	}
+
+	sort.SliceStable(meetings, func(i, j int) bool {
+		return meetings[i].StartTime.Before(meetings[j].StartTime)
+	})
+	return meetings
+}
+
+// ClassRecurrence is a weekly class repeated over the semester: the class dated on its
+// first meeting, the last moment a meeting may start and the meetings left out on
+// holidays, dated like the first one.
+type ClassRecurrence struct {
+	Class    entity.ClassEntity
+	Until    time.Time
+	Holidays []time.Time
+}
+
+// SemesterRecurrences describes each weekly class as a recurrence over the semester,
+// the same meetings SemesterMeetings lists one by one. Classes on a weekday the
+// semester never reaches are left out.
+func SemesterRecurrences(classes []entity.ClassEntity, semester entity.SemesterEntity) []ClassRecurrence {
+	first, last := dateOf(semester.StartDate.UTC()), dateOf(semester.EndDate.UTC())
+	var recurrences []ClassRecurrence
+	for _, class := range classes {
+		day, ok := dayOfWeekDate(weekOf(first), class.DayOfWeek)
+		if !ok {
+			continue
+		}
+		if day.Before(first) {
+			day = day.AddDate(0, 0, 7)
+		}
+		if day.After(last) {
+			continue
+		}
+
+		recurrence := ClassRecurrence{Class: class, Until: last.Add(24*time.Hour - time.Second)}
+		recurrence.Class.StartTime = day.Add(clockOffset(class.StartTime))
+		recurrence.Class.EndTime = recurrence.Class.StartTime.Add(class.EndTime.Sub(class.StartTime))
+		for _, holiday := range semester.Holidays {
+			date := dateOf(holiday.Date.UTC())
+			if date.Weekday() == day.Weekday() && !date.Before(day) && !date.After(last) {
+				recurrence.Holidays = append(recurrence.Holidays, date.Add(clockOffset(class.StartTime)))
+			}
+		}
+		sort.Slice(recurrence.Holidays, func(i, j int) bool {
+			return recurrence.Holidays[i].Before(recurrence.Holidays[j])
+		})
+		recurrences = append(recurrences, recurrence)
+	}
+	return recurrences
+}
@@ -0,0 +1,190 @@
+package process
+
+import (
+	"fmt"
+
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+// Violation is a breach of a constraint. Classes holds the indexes, in the timetable,
+// of the classes involved and Count how many violations it stands for, so a professor
+// two hours over the limit is a single violation counting two.
+type Violation struct {
+	Classes []int
+	Count   int
+}
+
+// Constraint is a rule timetables are scored against. Hard constraints must hold for a
+// timetable to be usable, soft ones are preferences; the weight scales the penalty of
+// each violation.
+type Constraint interface {
+	Name() string
+	Hard() bool
+	Weight() float64
+	Evaluate(timetable *entity.Timetable) []Violation
+}
+
+// ConstraintDefinition is a constraint as kept in the registry. Evaluate checks a
+// timetable against a parameterization and Weight, when set, gives the weight used when
+// the parameterization does not configure one; otherwise the weight is 1.
+type ConstraintDefinition struct {
+	Name        string
+	Description string
+	Hard        bool
+	Weight      func(parameterization entity.ParameterizationEntity) float64
+	Evaluate    func(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []Violation
+}
+
+// DefaultWeight is the weight of the constraint when the parameterization does not configure one.
+func (d ConstraintDefinition) DefaultWeight(parameterization entity.ParameterizationEntity) float64 {
+	if d.Weight == nil {
+		return 1.0
+	}
+	return d.Weight(parameterization)
+}
+
+var constraintRegistry = []ConstraintDefinition{
+	{
+		Name:        "credit_goal",
+		Description: "Credits offered must not exceed the parameterization budget",
+		Hard:        true,
+		Evaluate:    EvaluateCreditGoals,
+	},
+	{
+		Name:        "sections",
+		Description: "Every discipline has the parameterized number of sections with the hours its credits require",
+		Hard:        true,
+		Evaluate:    EvaluateSections,
+	},
+	{
+		Name:        "no_overlaps",
+		Description: "A professor, a section or the students of a curriculum term are never in two classes at the same time",
+		Hard:        true,
+		Evaluate: func(timetable *entity.Timetable, _ entity.ParameterizationEntity) []Violation {
+			return EvaluateNoOverlaps(timetable)
+		},
+	},
+	{
+		Name:        "rooms",
+		Description: "Every class has a free room of the right type and size",
+		Hard:        true,
+		Evaluate:    EvaluateRooms,
+	},
+	{
+		Name:        "teacher_hours",
+		Description: "Professors are not given more hours than they have to allocate",
+		Hard:        true,
+		Evaluate:    EvaluateTeacherHours,
+	},
+	{
+		Name:        "availability",
+		Description: "Every class is within the availability of its professor",
+		Hard:        true,
+		Evaluate:    EvaluateAvailability,
+	},
+	{
+		Name:        "spread",
+		Description: "Class hours are divided evenly over the days of the time grid and the shifts in use",
+		Weight: func(parameterization entity.ParameterizationEntity) float64 {
+			spread, _, _ := DistributionWeights(parameterization)
+			return spread
+		},
+		Evaluate: EvaluateSpread,
+	},
+	{
+		Name:        "same_day",
+		Description: "A section does not meet twice on the same day",
+		Weight: func(parameterization entity.ParameterizationEntity) float64 {
+			_, sameDay, _ := DistributionWeights(parameterization)
+			return sameDay
+		},
+		Evaluate: func(timetable *entity.Timetable, _ entity.ParameterizationEntity) []Violation {
+			return EvaluateSameDay(timetable)
+		},
+	},
+	{
+		Name:        "idle_gaps",
+		Description: "Students have no idle hours between the classes of a shift",
+		Weight: func(parameterization entity.ParameterizationEntity) float64 {
+			_, _, idleGap := DistributionWeights(parameterization)
+			return idleGap
+		},
+		Evaluate: func(timetable *entity.Timetable, _ entity.ParameterizationEntity) []Violation {
+			return EvaluateIdleGaps(timetable)
+		},
+	},
+}
+
+// RegisterConstraint adds a constraint to the registry, making it part of the fitness of
+// every parameterization that does not disable it.
+func RegisterConstraint(definition ConstraintDefinition) {
+	if _, ok := FindConstraint(definition.Name); ok {
+		panic(fmt.Sprintf("constraint %q already registered", definition.Name))
+	}
+	constraintRegistry = append(constraintRegistry, definition)
+}
+
+// RegisteredConstraints lists the constraints in the order they were registered.
+func RegisteredConstraints() []ConstraintDefinition {
+	return append([]ConstraintDefinition(nil), constraintRegistry...)
+}
+
+func FindConstraint(name string) (ConstraintDefinition, bool) {
+	for _, definition := range constraintRegistry {
+		if definition.Name == name {
+			return definition, true
+		}
+	}
+	return ConstraintDefinition{}, false
+}
+
+// Constraints builds the constraints the parameterization scores timetables with: every
+// registered one it does not disable, with the weight it configures or the default one.
+func Constraints(parameterization entity.ParameterizationEntity) []Constraint {
+	configured := make(map[string]entity.ParameterizationConstraintEntity)
+	for _, config := range parameterization.Constraints {
+		configured[config.Name] = config
+	}
+
+	var constraints []Constraint
+	for _, definition := range constraintRegistry {
+		weight := definition.DefaultWeight(parameterization)
+		if config, ok := configured[definition.Name]; ok {
+			if !config.Enabled {
+				continue
+			}
+			if config.Weight > 0 {
+				weight = config.Weight
+			}
+		}
+		constraints = append(constraints, &registeredConstraint{
+			definition:       definition,
+			weight:           weight,
+			parameterization: parameterization,
+		})
+	}
+	return constraints
+}
+
+// registeredConstraint binds a registered definition to the parameterization it scores for.
+type registeredConstraint struct {
+	definition       ConstraintDefinition
+	weight           float64
+	parameterization entity.ParameterizationEntity
+}
+
+func (c *registeredConstraint) Name() string {
+	return c.definition.Name
+}
+
+func (c *registeredConstraint) Hard() bool {
+	return c.definition.Hard
+}
+
+func (c *registeredConstraint) Weight() float64 {
+	return c.weight
+}
+
+func (c *registeredConstraint) Evaluate(timetable *entity.Timetable) []Violation {
+	return c.definition.Evaluate(timetable, c.parameterization)
+}
@@ -0,0 +1,69 @@
+package process
+
+import (
+	"time"
+
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+// ConstraintViolation is a violation together with the constraint it breaks.
+type ConstraintViolation struct {
+	Constraint string
+	Hard       bool
+	Violation
+}
+
+// EvaluateViolations lists every violation of the constraints the parameterization
+// scores timetables with, in the order of the registry.
+func EvaluateViolations(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []ConstraintViolation {
+	var violations []ConstraintViolation
+	for _, constraint := range Constraints(parameterization) {
+		for _, violation := range constraint.Evaluate(timetable) {
+			violations = append(violations, ConstraintViolation{Constraint: constraint.Name(), Hard: constraint.Hard(), Violation: violation})
+		}
+	}
+	return violations
+}
+
+// BrokenHardConstraints names the hard constraints the edited timetable violates more
+// than the original one, so an edit is only blamed for the problems it brings and not
+// for the ones the timetable already had. Both timetables must have been scored.
+func BrokenHardConstraints(original, edited entity.Timetable) []string {
+	violations := make(map[string]int)
+	for _, score := range original.Breakdown {
+		violations[score.Name] = score.Violations
+	}
+
+	var broken []string
+	for _, score := range edited.Breakdown {
+		if score.Hard && score.Violations > violations[score.Name] {
+			broken = append(broken, score.Name)
+		}
+	}
+	return broken
+}
+
+// TimetableWeek is the Monday of the week the classes are dated in, or of the first
+// week of the semester when there are no classes.
+func TimetableWeek(classes []entity.ClassEntity, parameterization entity.ParameterizationEntity) time.Time {
+	if len(classes) == 0 {
+		return FirstWeek(parameterization)
+	}
+	return weekOf(classes[0].StartTime)
+}
+
+// PlaceClass moves the class to the weekday of the week starting at weekStart, between
+// the start and end times of day, and names the shift it falls in. It fails when the
+// weekday is not a day of the week.
+func PlaceClass(class entity.ClassEntity, parameterization entity.ParameterizationEntity, weekStart time.Time, dayOfWeek string, startTime, endTime time.Time) (entity.ClassEntity, bool) {
+	day, ok := dayOfWeekDate(weekStart, dayOfWeek)
+	if !ok {
+		return class, false
+	}
+
+	class.DayOfWeek = dayOfWeek
+	class.Shift = shiftAt(parameterization, TimeGrid(parameterization), dayOfWeek, startTime)
+	class.StartTime = day.Add(clockOffset(startTime))
+	class.EndTime = day.Add(clockOffset(endTime))
+	return class, true
+}
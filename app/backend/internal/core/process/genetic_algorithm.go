@@ -1,119 +1,356 @@
 package process
 
 import (
-	"log"
+	"math"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/robinsonvs/time-table-project/internal/entity"
 )
 
-func InitializePopulation(size int, disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, weeksToGenerate int, parameterization entity.ParameterizationEntity) []entity.Timetable {
+func InitializePopulation(rng *rand.Rand, size int, disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, weeksToGenerate int, parameterization entity.ParameterizationEntity) ([]entity.Timetable, error) {
 	population := make([]entity.Timetable, size)
 	for i := 0; i < size; i++ {
-		population[i] = GenerateRandomTimetable(disciplines, professors, availabilities, weeksToGenerate, parameterization)
+		timetable, err := GenerateRandomTimetable(rng, disciplines, professors, availabilities, weeksToGenerate, parameterization)
+		if err != nil {
+			return nil, err
+		}
+		population[i] = timetable
 	}
-	return population
+	return population, nil
 }
 
-func GenerateRandomTimetable(disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, weeksToGenerate int, parameterization entity.ParameterizationEntity) entity.Timetable {
+func GenerateRandomTimetable(rng *rand.Rand, disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, weeksToGenerate int, parameterization entity.ParameterizationEntity) (entity.Timetable, error) {
 	var timetable entity.Timetable
-	occupiedSlots := make(map[string][]time.Time)
 	allocatedHours := make(map[int64]float64)
-	allocatedCredits := int32(0)
-	timeStartProcess := time.Date(2024, 10, 7, 0, 0, 0, 0, time.UTC)
+	timeStartProcess := FirstWeek(parameterization)
+	numSections := SectionsPerDiscipline(parameterization)
+	grid := TimeGrid(parameterization)
 
 	for week := 0; week < weeksToGenerate; week++ {
-		//weekStart := time.Now().AddDate(0, 0, week*7)
 		weekStart := timeStartProcess.AddDate(0, 0, week*7)
 
-		for day := 0; day < 5; day++ {
-			weekDay := weekStart.AddDate(0, 0, day)
-
-			for _, shift := range []string{"Morning", "Afternoon", "Night"} {
-
-				for _, discipline := range disciplines {
+		locked, err := LockedClasses(parameterization, disciplines, weekStart)
+		if err != nil {
+			return timetable, err
+		}
+		timetable.Classes = append(timetable.Classes, locked...)
+		for _, class := range locked {
+			allocatedHours[class.ProfessorID] += ClassHours(class, grid)
+		}
+		pinned := lockedSections(locked, grid)
 
-					//if allocatedCredits >= parameterization.MaxCreditsToOffer {
-					//	break
-					//}
+		for _, discipline := range disciplines {
+			blocks := ClassBlocks(discipline, parameterization)
+			sectionHours := 0
+			for _, hours := range blocks {
+				sectionHours += hours
+			}
 
-					availableProfessors := FilterEligibleProfessors(discipline.ID, professors)
-					if len(availableProfessors) == 0 {
+			for section := int32(1); section <= numSections; section++ {
+				// a locked section only gets the hours its locked classes leave, with their professor
+				if locked, ok := pinned[sectionKey{disciplineID: discipline.ID, section: section}]; ok {
+					remaining := remainingBlocks(blocks, locked.hours, parameterization)
+					professor, ok := findProfessor(locked.professorID, professors)
+					if len(remaining) == 0 || !ok {
 						continue
 					}
-					professor := availableProfessors[rand.Intn(len(availableProfessors))]
-					availableSlots := FilterAvailableSlots(professor.ID, availabilities)
-					if len(availableSlots) == 0 {
+					classes, ok := scheduleSection(rng, discipline, section, remaining, professor, availabilities, parameterization.Rooms, grid, weekStart, timetable.Classes)
+					if ok {
+						timetable.Classes = append(timetable.Classes, classes...)
+						allocatedHours[professor.ID] += float64(sectionHours) - locked.hours
+					}
+					continue
+				}
+
+				// every section gets its own professor, tried in random order until one fits
+				availableProfessors := FilterEligibleProfessors(discipline.ID, professors)
+				for _, p := range rng.Perm(len(availableProfessors)) {
+					professor := availableProfessors[p]
+					if int(allocatedHours[professor.ID])+sectionHours > int(professor.HoursToAllocate) {
 						continue
 					}
 
-					slot := findMatchingSlot(shift, availableSlots, weekDay)
-					if slot == nil {
+					classes, ok := scheduleSection(rng, discipline, section, blocks, professor, availabilities, parameterization.Rooms, grid, weekStart, timetable.Classes)
+					if !ok {
 						continue
 					}
+					timetable.Classes = append(timetable.Classes, classes...)
+					allocatedHours[professor.ID] += float64(sectionHours)
+					break
+				}
+			}
+		}
+	}
 
-					startTime, endTime := GenerateNextAvailableTime(weekDay, slot.Shift, occupiedSlots[slot.Shift], slot.DayOfWeek, timetable.Classes)
+	return timetable, nil
+}
 
-					zeroTime := time.Time{}
-					if startTime.Equal(zeroTime) {
-						break
-					}
+// scheduleSection places every block of a section in the professor's availability
+// for the week, on the periods of the grid, preferring to spread the blocks over
+// different days. It fails when any block cannot be placed.
+func scheduleSection(rng *rand.Rand, discipline entity.DisciplineEntity, section int32, blocks []int, professor entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, rooms []entity.RoomEntity, grid []entity.TimeSlotEntity, weekStart time.Time, classes []entity.ClassEntity) ([]entity.ClassEntity, bool) {
+	availableSlots := FilterAvailableSlots(professor.ID, availabilities)
+	if len(availableSlots) == 0 {
+		return nil, false
+	}
 
-					classDuration := endTime.Sub(startTime).Hours()
-					if int(allocatedHours[professor.ID]+classDuration) > int(professor.HoursToAllocate) {
-						continue
-					}
+	var sectionClasses []entity.ClassEntity
+	usedDays := make(map[string]bool)
+	for _, hours := range blocks {
+		scheduled := append(classes[:len(classes):len(classes)], sectionClasses...)
+		class, ok := scheduleBlock(rng, availableSlots, grid, weekStart, hours, usedDays, scheduled, professor.ID, discipline, section, rooms)
+		if !ok {
+			return nil, false
+		}
+		class.Section = section
+		class.DisciplineID = discipline.ID
+		class.ProfessorID = professor.ID
+		class.Discipline = &discipline
+		sectionClasses = append(sectionClasses, class)
+		usedDays[class.DayOfWeek] = true
+	}
+	return sectionClasses, true
+}
 
-					class := entity.ClassEntity{
-						DayOfWeek:    slot.DayOfWeek,
-						Shift:        slot.Shift,
-						StartTime:    startTime,
-						EndTime:      endTime,
-						DisciplineID: discipline.ID,
-						ProfessorID:  professor.ID,
-					}
+// scheduleBlock finds a time for a block in one of the available slots, taking as many
+// consecutive periods of the grid as the block has hours. Without
+// rooms the course is a single track and the block may not overlap any class; with
+// rooms the block only has to avoid the classes of the professor, of the students of
+// the section and in the room it takes. Rooms that fit the discipline are tried first, in every slot, before
+// settling for one of the wrong type or too small, which the fitness then penalizes.
+func scheduleBlock(rng *rand.Rand, availableSlots []entity.AvailabilityEntity, grid []entity.TimeSlotEntity, weekStart time.Time, hours int, usedDays map[string]bool, classes []entity.ClassEntity, professorID int64, discipline entity.DisciplineEntity, section int32, rooms []entity.RoomEntity) (entity.ClassEntity, bool) {
+	order := rng.Perm(len(availableSlots))
+	group := studentGroup{term: discipline.Term, section: section}
+	tiers := [][]entity.RoomEntity{nil}
+	if len(rooms) > 0 {
+		tiers = roomTiers(discipline, rooms)
+	}
 
-					timetable.Classes = append(timetable.Classes, class)
-					occupiedSlots[slot.Shift] = append(occupiedSlots[slot.Shift], startTime)
-					allocatedHours[professor.ID] += classDuration
-					allocatedCredits += discipline.Credits
+	for _, tier := range tiers {
+		for _, allowUsedDays := range []bool{false, true} {
+			for _, a := range order {
+				slot := availableSlots[a]
+				if usedDays[slot.DayOfWeek] && !allowUsedDays {
+					continue
+				}
+				weekDay, ok := dayOfWeekDate(weekStart, slot.DayOfWeek)
+				if !ok {
+					continue
+				}
 
+				if len(rooms) == 0 {
+					if class, ok := NextAvailablePeriods(weekDay, slot, grid, classes, hours); ok {
+						return class, true
+					}
+					continue
+				}
+
+				for _, room := range tier {
+					if class, ok := NextAvailablePeriods(weekDay, slot, grid, conflictingClasses(classes, professorID, room.ID, group), hours); ok {
+						class.RoomID = room.ID
+						return class, true
+					}
 				}
 			}
+		}
+	}
+	return entity.ClassEntity{}, false
+}
 
+// roomTiers splits the rooms into the ones that fit the discipline, smallest first,
+// and the rest, largest first.
+func roomTiers(discipline entity.DisciplineEntity, rooms []entity.RoomEntity) [][]entity.RoomEntity {
+	var fitting, others []entity.RoomEntity
+	for _, room := range rooms {
+		if RoomFits(room, discipline) {
+			fitting = append(fitting, room)
+		} else {
+			others = append(others, room)
 		}
 	}
+	sort.SliceStable(fitting, func(i, j int) bool { return fitting[i].Capacity < fitting[j].Capacity })
+	sort.SliceStable(others, func(i, j int) bool { return others[i].Capacity > others[j].Capacity })
+	return [][]entity.RoomEntity{fitting, others}
+}
 
-	return timetable
+// RoomFits tells whether the room is of the type the discipline requires and seats its expected enrolment.
+func RoomFits(room entity.RoomEntity, discipline entity.DisciplineEntity) bool {
+	return room.Type == discipline.RoomType && room.Capacity >= discipline.ExpectedEnrolment
 }
 
-func findMatchingSlot(shift string, availableSlots []entity.AvailabilityEntity, weekDay time.Time) *entity.AvailabilityEntity {
-	for i, availableSlot := range availableSlots {
-		if availableSlot.DayOfWeek == weekDay.Weekday().String() && availableSlot.Shift == shift {
-			return &availableSlots[i]
+// conflictingClasses returns the classes a new class cannot overlap: the ones of its
+// professor, in its room or attended by the same students.
+func conflictingClasses(classes []entity.ClassEntity, professorID, roomID int64, group studentGroup) []entity.ClassEntity {
+	var busy []entity.ClassEntity
+	for _, class := range classes {
+		if class.ProfessorID == professorID || class.RoomID == roomID || (group.term > 0 && groupOf(class) == group) {
+			busy = append(busy, class)
 		}
 	}
-	return nil
+	return busy
 }
 
-func RunGeneticAlgorithm(disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, parameterization entity.ParameterizationEntity, weeksToGenerate int) entity.Timetable {
-	rand.Seed(time.Now().UnixNano())
+// dayOfWeekDate returns the date of the given weekday (Monday to Saturday) in the week starting at weekStart.
+func dayOfWeekDate(weekStart time.Time, dayOfWeek string) (time.Time, bool) {
+	for day := 0; day < len(ScheduleWeekdays); day++ {
+		weekDay := weekStart.AddDate(0, 0, day)
+		if weekDay.Weekday().String() == dayOfWeek {
+			return weekDay, true
+		}
+	}
+	return time.Time{}, false
+}
 
-	populationSize := 100
-	population := InitializePopulation(populationSize, disciplines, professors, availabilities, weeksToGenerate, parameterization)
+// SectionsPerDiscipline is the number of class sections each offered discipline must have.
+func SectionsPerDiscipline(parameterization entity.ParameterizationEntity) int32 {
+	if parameterization.NumClassesPerDiscipline < 1 {
+		return 1
+	}
+	return parameterization.NumClassesPerDiscipline
+}
 
-	for i := 0; i < 1000; i++ {
+// CreditHours reads the rule that turns discipline credits into weekly class hours,
+// falling back to the defaults when the parameterization leaves it unset.
+func CreditHours(parameterization entity.ParameterizationEntity) (hoursPerCredit, maxBlockHours int) {
+	hoursPerCredit = int(entity.DefaultHoursPerCredit)
+	if parameterization.HoursPerCredit > 0 {
+		hoursPerCredit = int(parameterization.HoursPerCredit)
+	}
+	maxBlockHours = int(entity.DefaultMaxBlockHours)
+	if parameterization.MaxBlockHours > 0 {
+		maxBlockHours = int(parameterization.MaxBlockHours)
+	}
+	return hoursPerCredit, maxBlockHours
+}
+
+// ClassBlocks splits the weekly hours of a discipline (credits times hours per credit)
+// into contiguous blocks no longer than the maximum block length, e.g. 4 hours with
+// blocks of at most 2 hours become two 2-hour classes.
+func ClassBlocks(discipline entity.DisciplineEntity, parameterization entity.ParameterizationEntity) []int {
+	hoursPerCredit, maxBlockHours := CreditHours(parameterization)
+	total := int(discipline.Credits) * hoursPerCredit
+	if total < 1 {
+		total = 1
+	}
+	return splitHours(total, maxBlockHours)
+}
+
+// splitHours splits the hours into contiguous blocks no longer than maxBlockHours.
+func splitHours(total, maxBlockHours int) []int {
+	var blocks []int
+	for total > 0 {
+		hours := min(total, maxBlockHours)
+		blocks = append(blocks, hours)
+		total -= hours
+	}
+	return blocks
+}
+
+// SelectDisciplinesToOffer narrows the course disciplines down to the ones the
+// parameterization offers. Mandatory disciplines are always kept; the others are
+// added by descending priority while their credits still fit in MaxCreditsToOffer.
+func SelectDisciplinesToOffer(disciplines []entity.DisciplineEntity, offered []entity.ParameterizationDisciplineEntity, maxCreditsToOffer int32) []entity.DisciplineEntity {
+	ordered := make([]entity.ParameterizationDisciplineEntity, len(offered))
+	copy(ordered, offered)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Mandatory != ordered[j].Mandatory {
+			return ordered[i].Mandatory
+		}
+		return ordered[i].Priority > ordered[j].Priority
+	})
+
+	var selected []entity.DisciplineEntity
+	var credits int32
+	for _, entry := range ordered {
+		discipline, ok := findDiscipline(entry.DisciplineID, disciplines)
+		if !ok {
+			continue
+		}
+		if !entry.Mandatory && credits+discipline.Credits > maxCreditsToOffer {
+			continue
+		}
+		selected = append(selected, discipline)
+		credits += discipline.Credits
+	}
+	return selected
+}
+
+// CurriculumDisciplines lists the disciplines of a curriculum matrix, each in the term the
+// matrix places it rather than the one registered in the discipline.
+func CurriculumDisciplines(curriculum []entity.CurriculumMatrixDisciplineEntity) []entity.DisciplineEntity {
+	var disciplines []entity.DisciplineEntity
+	for _, entry := range curriculum {
+		if entry.Discipline == nil {
+			continue
+		}
+		discipline := *entry.Discipline
+		discipline.Term = entry.Term
+		disciplines = append(disciplines, discipline)
+	}
+	return disciplines
+}
+
+// WithDisciplineTerms places the disciplines of the classes in the terms of the given
+// disciplines, the ones timetables are generated with, so classes read back are scored
+// the way they were generated. Classes of other disciplines keep their terms.
+func WithDisciplineTerms(classes []entity.ClassEntity, disciplines []entity.DisciplineEntity) []entity.ClassEntity {
+	terms := make(map[int64]int32)
+	for _, discipline := range disciplines {
+		terms[discipline.ID] = discipline.Term
+	}
+	for i := range classes {
+		term, ok := terms[classes[i].DisciplineID]
+		if !ok || classes[i].Discipline == nil {
+			continue
+		}
+		discipline := *classes[i].Discipline
+		discipline.Term = term
+		classes[i].Discipline = &discipline
+	}
+	return classes
+}
+
+// CurriculumOffer turns a curriculum matrix into the disciplines to offer: mandatory ones
+// always and electives while their credits fit, as SelectDisciplinesToOffer does for the
+// ones a parameterization chooses.
+func CurriculumOffer(curriculum []entity.CurriculumMatrixDisciplineEntity) []entity.ParameterizationDisciplineEntity {
+	var offered []entity.ParameterizationDisciplineEntity
+	for _, entry := range curriculum {
+		offered = append(offered, entity.ParameterizationDisciplineEntity{
+			DisciplineID: entry.DisciplineID,
+			Mandatory:    entry.Mandatory,
+		})
+	}
+	return offered
+}
+
+// RunGeneticAlgorithm evolves the population and returns the fittest timetable.
+// All randomness comes from rng, so the same seed and input always yield the
+// same timetable. onProgress, when not nil, is called after every generation with the number of
+// generations done so far and the total.
+func RunGeneticAlgorithm(rng *rand.Rand, disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, parameterization entity.ParameterizationEntity, weeksToGenerate int, onProgress func(done, total int)) (entity.Timetable, error) {
+	populationSize, generations, tournamentSize, mutationRate := Hyperparameters(parameterization)
+	population, err := InitializePopulation(rng, populationSize, disciplines, professors, availabilities, weeksToGenerate, parameterization)
+	if err != nil {
+		return entity.Timetable{}, err
+	}
+
+	for i := 0; i < generations; i++ {
 		newPopulation := make([]entity.Timetable, populationSize)
 		for j := 0; j < populationSize; j++ {
-			parent1 := TournamentSelection(population)
-			parent2 := TournamentSelection(population)
-			child := Crossover(parent1, parent2)
-			Mutate(&child, disciplines, professors, availabilities)
+			parent1 := TournamentSelection(rng, population, tournamentSize)
+			parent2 := TournamentSelection(rng, population, tournamentSize)
+			child := Crossover(rng, parent1, parent2)
+			Mutate(rng, &child, disciplines, professors, availabilities, parameterization, mutationRate)
 			EvaluateFitness(&child, parameterization)
 			newPopulation[j] = child
 		}
 		ReplacePopulation(population, newPopulation)
+		if onProgress != nil {
+			onProgress(i+1, generations)
+		}
 	}
 
 	best := population[0]
@@ -123,49 +360,31 @@ func RunGeneticAlgorithm(disciplines []entity.DisciplineEntity, professors []ent
 		}
 	}
 
-	return best
+	return best, nil
 }
 
-func GenerateNextAvailableTime(weekDay time.Time, shift string, occupiedTimes []time.Time, dayOfWeek string, classes []entity.ClassEntity) (time.Time, time.Time) {
-	var startHour, endHour int
-	now := weekDay
-	switch shift {
-	case "Morning":
-		startHour = 8
-		endHour = 12
-	case "Afternoon":
-		startHour = 13
-		endHour = 18
-	case "Night":
-		startHour = 19
-		endHour = 23
-	default:
-		log.Fatal("invalid shift")
+// Hyperparameters reads the genetic algorithm settings of the parameterization,
+// falling back to the defaults for the sizes left unset. The mutation rate is taken
+// as it is, as a rate of 0 turns mutation off.
+func Hyperparameters(parameterization entity.ParameterizationEntity) (populationSize, generations, tournamentSize int, mutationRate float64) {
+	populationSize = int(entity.DefaultPopulationSize)
+	if parameterization.PopulationSize > 0 {
+		populationSize = int(parameterization.PopulationSize)
 	}
-	for hour := startHour; hour < endHour; hour++ {
-		startTime := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
-		if !isTimeOccupied(startTime, occupiedTimes) && !isClassScheduled(classes, dayOfWeek, startTime, shift) {
-			endTime := startTime.Add(time.Hour)
-			return startTime, endTime
-		}
+	generations = int(entity.DefaultGenerations)
+	if parameterization.Generations > 0 {
+		generations = int(parameterization.Generations)
 	}
-
-	lastSlot := time.Time{}
-	return lastSlot, lastSlot.Add(time.Hour)
-}
-
-func isTimeOccupied(timeToCheck time.Time, occupiedTimes []time.Time) bool {
-	for _, occupiedTime := range occupiedTimes {
-		if occupiedTime.Equal(timeToCheck) {
-			return true
-		}
+	tournamentSize = int(entity.DefaultTournamentSize)
+	if parameterization.TournamentSize > 0 {
+		tournamentSize = int(parameterization.TournamentSize)
 	}
-	return false
+	return populationSize, generations, tournamentSize, parameterization.MutationRate
 }
 
-func isClassScheduled(classes []entity.ClassEntity, dayOfWeek string, startTime time.Time, shift string) bool {
+func isClassScheduled(classes []entity.ClassEntity, dayOfWeek string, startTime, endTime time.Time) bool {
 	for _, class := range classes {
-		if class.DayOfWeek == dayOfWeek && class.Shift == shift && class.StartTime.Equal(startTime) {
+		if class.DayOfWeek == dayOfWeek && class.StartTime.Before(endTime) && startTime.Before(class.EndTime) {
 			return true
 		}
 	}
@@ -194,67 +413,402 @@ func FilterAvailableSlots(professorID int64, availabilities []entity.Availabilit
 	return availableSlots
 }
 
+// HardConstraintPenalty multiplies the violations of hard constraints, so a single one
+// outweighs the soft violations any realistic timetable accumulates.
+const HardConstraintPenalty = 1000.0
+
+// EvaluateFitness scores the timetable as 1 / (1 + penalty), where the penalty is the
+// weighted sum of the violations of the constraints the parameterization enables. A
+// timetable without violations scores 1, and each violation lowers the score, so the
+// search is guided by how many problems a timetable has and not only by whether it has
+// any. The per-constraint breakdown is kept in the timetable.
 func EvaluateFitness(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) {
-	fitness := 0.0
-	fitness += EvaluateCreditGoals(timetable, parameterization)
-	fitness += EvaluateDistribution(timetable)
-	fitness += EvaluateNoOverlaps(timetable)
-	fitness += EvaluateTeacherHours(timetable, parameterization)
-	timetable.Fitness = fitness
+	var breakdown []entity.ConstraintScore
+	penalty := 0.0
+	for _, constraint := range Constraints(parameterization) {
+		score := scoreConstraint(constraint, constraint.Evaluate(timetable))
+		penalty += score.Penalty
+		breakdown = append(breakdown, score)
+	}
+	timetable.Fitness = 1.0 / (1.0 + penalty)
+	timetable.Breakdown = breakdown
 }
 
-func EvaluateCreditGoals(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) float64 {
+func scoreConstraint(constraint Constraint, violations []Violation) entity.ConstraintScore {
+	count := 0
+	for _, violation := range violations {
+		count += violation.Count
+	}
+	penalty := constraint.Weight() * float64(count)
+	if constraint.Hard() {
+		penalty *= HardConstraintPenalty
+	}
+	return entity.ConstraintScore{
+		Name:       constraint.Name(),
+		Hard:       constraint.Hard(),
+		Weight:     constraint.Weight(),
+		Violations: count,
+		Penalty:    penalty,
+	}
+}
+
+// EvaluateCreditGoals reports the credits offered beyond the parameterization budget,
+// referencing the classes of the disciplines that exceed it.
+func EvaluateCreditGoals(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []Violation {
 	var creditCount int32 = 0
+	offered := make(map[int64]bool)
+	var exceeding []int64
 	for _, class := range timetable.Classes {
+		if offered[class.DisciplineID] {
+			continue // the credits of a discipline count once, however many sections it has
+		}
 		for _, discipline := range parameterization.Disciplines {
 			if class.DisciplineID == discipline.ID {
+				offered[class.DisciplineID] = true
 				creditCount += discipline.Credits
+				if creditCount > parameterization.MaxCreditsToOffer {
+					exceeding = append(exceeding, discipline.ID)
+				}
 			}
 		}
 	}
 	if creditCount <= parameterization.MaxCreditsToOffer {
-		return 1.0
+		return nil
+	}
+
+	violation := Violation{Count: int(creditCount - parameterization.MaxCreditsToOffer)}
+	for i, class := range timetable.Classes {
+		for _, disciplineID := range exceeding {
+			if class.DisciplineID == disciplineID {
+				violation.Classes = append(violation.Classes, i)
+			}
+		}
+	}
+	return []Violation{violation}
+}
+
+// EvaluateSections reports the sections that differ from the parameterization: every
+// offered discipline should have exactly the parameterized number of sections, each
+// one with the weekly hours its credits require, so missing, excess and wrongly sized
+// sections are violations.
+func EvaluateSections(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []Violation {
+	if len(parameterization.Disciplines) == 0 {
+		return nil
+	}
+	numSections := SectionsPerDiscipline(parameterization)
+	grid := TimeGrid(parameterization)
+
+	weeks := make(map[time.Time]bool)
+	sectionHours := make(map[sectionKey]float64)
+	sectionClasses := make(map[sectionKey][]int)
+	var keys []sectionKey
+	for i, class := range timetable.Classes {
+		weeks[weekOf(class.StartTime)] = true
+		key := sectionKey{disciplineID: class.DisciplineID, section: class.Section}
+		if _, ok := sectionClasses[key]; !ok {
+			keys = append(keys, key)
+		}
+		sectionHours[key] += ClassHours(class, grid)
+		sectionClasses[key] = append(sectionClasses[key], i)
+	}
+
+	var violations []Violation
+	for _, discipline := range parameterization.Disciplines {
+		expectedHours := 0
+		for _, hours := range ClassBlocks(discipline, parameterization) {
+			expectedHours += hours
+		}
+		expectedHours *= max(len(weeks), 1)
+
+		for _, key := range keys {
+			if key.disciplineID == discipline.ID && (key.section < 1 || key.section > numSections) {
+				violations = append(violations, Violation{Classes: sectionClasses[key], Count: 1}) // excess section
+			}
+		}
+		for section := int32(1); section <= numSections; section++ {
+			key := sectionKey{disciplineID: discipline.ID, section: section}
+			classes, ok := sectionClasses[key]
+			if !ok {
+				violations = append(violations, Violation{Count: 1}) // missing section
+			} else if int(sectionHours[key]) != expectedHours {
+				violations = append(violations, Violation{Classes: classes, Count: 1}) // section with more or fewer hours than its credits require
+			}
+		}
+	}
+	return violations
+}
+
+// weekOf returns the Monday of the week the time falls in.
+func weekOf(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// studentGroup identifies the students attending a class: the ones of the curriculum
+// term of its discipline, who take the same section of every discipline of the term
+// (Turma A takes the A sections). Disciplines outside any term have term 0.
+type studentGroup struct {
+	term    int32
+	section int32
+}
+
+func groupOf(class entity.ClassEntity) studentGroup {
+	group := studentGroup{section: class.Section}
+	if class.Discipline != nil {
+		group.term = class.Discipline.Term
+	}
+	return group
+}
+
+// sameStudents tells whether two classes are attended by the same students, which is
+// only known when their disciplines are in a curriculum term.
+func sameStudents(class1, class2 entity.ClassEntity) bool {
+	group := groupOf(class1)
+	return group.term > 0 && group == groupOf(class2)
+}
+
+// The distribution constraints look at the classes students take together, so every
+// student group is checked on its own; disciplines outside any term are grouped by
+// section only.
+
+// EvaluateSpread reports, for every group, the class hours that would have to move for
+// them to be divided evenly over the days of the grid and over the shifts in use.
+func EvaluateSpread(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []Violation {
+	grid := TimeGrid(parameterization)
+	scheduleDays := ScheduleDays(grid)
+	keys, groups := distributionGroups(timetable.Classes)
+	var violations []Violation
+	for _, key := range keys {
+		dayHours := make(map[string]float64)
+		shiftHours := make(map[string]float64)
+		var shifts []string
+		for _, i := range groups[key] {
+			class := timetable.Classes[i]
+			hours := ClassHours(class, grid)
+			dayHours[class.DayOfWeek] += hours
+			if _, ok := shiftHours[class.Shift]; !ok {
+				shifts = append(shifts, class.Shift)
+			}
+			shiftHours[class.Shift] += hours
+		}
+
+		days := make([]float64, len(scheduleDays))
+		for i, day := range scheduleDays {
+			days[i] = dayHours[day]
+		}
+		inShifts := make([]float64, len(shifts))
+		for i, shift := range shifts {
+			inShifts[i] = shiftHours[shift]
+		}
+		if excess := int(math.Round(excessOverMean(days) + excessOverMean(inShifts))); excess > 0 {
+			violations = append(violations, Violation{Classes: groups[key], Count: excess})
+		}
 	}
-	return 0.0
+	return violations
+}
+
+// DistributionWeights reads the weights of the distribution criteria. A weight of 0
+// leaves its criterion out of the fitness.
+func DistributionWeights(parameterization entity.ParameterizationEntity) (spread, sameDay, idleGap float64) {
+	return parameterization.SpreadWeight, parameterization.SameDayWeight, parameterization.IdleGapWeight
 }
 
-func EvaluateDistribution(timetable *entity.Timetable) float64 {
-	return 1.0
+// distributionGroups splits the indexes of the classes by student group, keeping the
+// order in which the groups first appear so the result does not depend on map iteration.
+func distributionGroups(classes []entity.ClassEntity) ([]studentGroup, map[studentGroup][]int) {
+	var keys []studentGroup
+	groups := make(map[studentGroup][]int)
+	for i, class := range classes {
+		group := groupOf(class)
+		if _, ok := groups[group]; !ok {
+			keys = append(keys, group)
+		}
+		groups[group] = append(groups[group], i)
+	}
+	return keys, groups
 }
 
-func EvaluateNoOverlaps(timetable *entity.Timetable) float64 {
+// excessOverMean is how much the values above the mean exceed it, that is, how much
+// has to be moved for all values to be equal.
+func excessOverMean(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	total := 0.0
+	for _, value := range values {
+		total += value
+	}
+	mean := total / float64(len(values))
+	excess := 0.0
+	for _, value := range values {
+		excess += math.Max(value-mean, 0)
+	}
+	return excess
+}
+
+// EvaluateSameDay reports the classes of a section held on a day the section already
+// meets, together with the first class of that day.
+func EvaluateSameDay(timetable *entity.Timetable) []Violation {
+	type sectionDay struct {
+		disciplineID int64
+		section      int32
+		date         time.Time
+	}
+	first := make(map[sectionDay]int)
+	var violations []Violation
+	for i, class := range timetable.Classes {
+		key := sectionDay{class.DisciplineID, class.Section, dateOf(class.StartTime)}
+		if j, ok := first[key]; ok {
+			violations = append(violations, Violation{Classes: []int{j, i}, Count: 1})
+			continue
+		}
+		first[key] = i
+	}
+	return violations
+}
+
+// EvaluateIdleGaps reports the idle hours students of a group spend between the classes
+// of a shift, referencing the classes around each gap.
+func EvaluateIdleGaps(timetable *entity.Timetable) []Violation {
+	keys, groups := distributionGroups(timetable.Classes)
+	var violations []Violation
+	for _, key := range keys {
+		sorted := append([]int(nil), groups[key]...)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return timetable.Classes[sorted[i]].StartTime.Before(timetable.Classes[sorted[j]].StartTime)
+		})
+
+		type shiftDay struct {
+			date  time.Time
+			shift string
+		}
+		last := make(map[shiftDay]int)
+		for _, i := range sorted {
+			class := timetable.Classes[i]
+			key := shiftDay{dateOf(class.StartTime), class.Shift}
+			j, ok := last[key]
+			if ok && class.StartTime.After(timetable.Classes[j].EndTime) {
+				if idle := int(math.Round(class.StartTime.Sub(timetable.Classes[j].EndTime).Hours())); idle > 0 {
+					violations = append(violations, Violation{Classes: []int{j, i}, Count: idle})
+				}
+			}
+			if !ok || class.EndTime.After(timetable.Classes[j].EndTime) {
+				last[key] = i
+			}
+		}
+	}
+	return violations
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// EvaluateNoOverlaps reports the pairs of classes held at the same time by the same
+// professor, for the same section of a discipline or for the same students of a
+// curriculum term.
+func EvaluateNoOverlaps(timetable *entity.Timetable) []Violation {
+	var violations []Violation
 	for i, class1 := range timetable.Classes {
-		for j, class2 := range timetable.Classes {
-			if i != j && class1.DayOfWeek == class2.DayOfWeek && class1.Shift == class2.Shift {
-				if class1.ProfessorID == class2.ProfessorID || class1.DisciplineID == class2.DisciplineID {
-					if class1.StartTime == class2.StartTime { // test if you don't have two classes in a row with the same teacher
-						return 0.0
+		for j := i + 1; j < len(timetable.Classes); j++ {
+			class2 := timetable.Classes[j]
+			if class1.DayOfWeek == class2.DayOfWeek && class1.Shift == class2.Shift {
+				if class1.ProfessorID == class2.ProfessorID || (class1.DisciplineID == class2.DisciplineID && class1.Section == class2.Section) || sameStudents(class1, class2) {
+					if class1.StartTime.Before(class2.EndTime) && class2.StartTime.Before(class1.EndTime) { // test if you don't have two classes in a row with the same teacher
+						violations = append(violations, Violation{Classes: []int{i, j}, Count: 1})
 					}
 				}
 			}
 		}
 	}
-	return 1.0
+	return violations
 }
 
-func EvaluateTeacherHours(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) float64 {
+// EvaluateRooms reports the room problems: a class without a room, in a room of the
+// wrong type or too small for the expected enrolment, and every pair of classes sharing
+// a room at the same time. Without registered rooms there is nothing to check.
+func EvaluateRooms(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []Violation {
+	if len(parameterization.Rooms) == 0 {
+		return nil
+	}
+
+	rooms := make(map[int64]entity.RoomEntity)
+	for _, room := range parameterization.Rooms {
+		rooms[room.ID] = room
+	}
+
+	var violations []Violation
+	for i, class := range timetable.Classes {
+		room, ok := rooms[class.RoomID]
+		if !ok {
+			violations = append(violations, Violation{Classes: []int{i}, Count: 1})
+			continue
+		}
+		if discipline, ok := findDiscipline(class.DisciplineID, parameterization.Disciplines); ok && !RoomFits(room, discipline) {
+			violations = append(violations, Violation{Classes: []int{i}, Count: 1})
+		}
+		for j := i + 1; j < len(timetable.Classes); j++ {
+			other := timetable.Classes[j]
+			if other.RoomID == class.RoomID && class.StartTime.Before(other.EndTime) && other.StartTime.Before(class.EndTime) {
+				violations = append(violations, Violation{Classes: []int{i, j}, Count: 1})
+			}
+		}
+	}
+	return violations
+}
+
+// EvaluateTeacherHours reports, for every professor, the hours given beyond the ones
+// they have to allocate, referencing the professor's classes.
+func EvaluateTeacherHours(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []Violation {
+	grid := TimeGrid(parameterization)
 	teacherHours := make(map[int64]float64)
-	for _, class := range timetable.Classes {
-		teacherHours[class.ProfessorID] += class.EndTime.Sub(class.StartTime).Hours()
+	teacherClasses := make(map[int64][]int)
+	for i, class := range timetable.Classes {
+		teacherHours[class.ProfessorID] += ClassHours(class, grid)
+		teacherClasses[class.ProfessorID] = append(teacherClasses[class.ProfessorID], i)
 	}
+	var violations []Violation
+	checked := make(map[int64]bool) // professors come once per eligible discipline
 	for _, professor := range parameterization.Professors {
-		if int(teacherHours[professor.ID]) > int(professor.HoursToAllocate) {
-			return 0.0 // Strongly penalizes schedules that exceed the teacher's availability
+		if checked[professor.ID] {
+			continue
+		}
+		checked[professor.ID] = true
+		if excess := int(teacherHours[professor.ID]) - int(professor.HoursToAllocate); excess > 0 {
+			violations = append(violations, Violation{Classes: teacherClasses[professor.ID], Count: excess})
 		}
 	}
-	return 1.0
+	return violations
 }
 
-func TournamentSelection(population []entity.Timetable) entity.Timetable {
-	tournamentSize := 5
+// EvaluateAvailability reports the classes outside every availability their professor
+// has on the day. Generated classes always fit, as they are only placed in availability,
+// but classes edited by hand may not. Without availabilities there is nothing to check.
+func EvaluateAvailability(timetable *entity.Timetable, parameterization entity.ParameterizationEntity) []Violation {
+	if len(parameterization.Availabilities) == 0 {
+		return nil
+	}
+
+	var violations []Violation
+	for i, class := range timetable.Classes {
+		available := false
+		for _, slot := range FilterAvailableSlots(class.ProfessorID, parameterization.Availabilities) {
+			if slot.DayOfWeek == class.DayOfWeek && clockOffset(slot.StartTime) <= clockOffset(class.StartTime) && clockOffset(class.EndTime) <= clockOffset(slot.EndTime) {
+				available = true
+				break
+			}
+		}
+		if !available {
+			violations = append(violations, Violation{Classes: []int{i}, Count: 1})
+		}
+	}
+	return violations
+}
+
+func TournamentSelection(rng *rand.Rand, population []entity.Timetable, tournamentSize int) entity.Timetable {
 	tournament := make([]entity.Timetable, tournamentSize)
 	for i := 0; i < tournamentSize; i++ {
-		randomIndex := rand.Intn(len(population))
+		randomIndex := rng.Intn(len(population))
 		tournament[i] = population[randomIndex]
 	}
 	best := tournament[0]
@@ -266,77 +820,135 @@ func TournamentSelection(population []entity.Timetable) entity.Timetable {
 	return best
 }
 
-func Crossover(parent1, parent2 entity.Timetable) entity.Timetable {
-	if parent1.Classes == nil || parent2.Classes == nil {
+// Crossover builds the child section by section: every class section scheduled by
+// both parents is inherited whole, with all its blocks, from one of them at random,
+// and the sections only one parent managed to schedule are kept, so sections are
+// never duplicated, dropped or split between professors. Locked classes are in every
+// parent, so every child keeps them.
+func Crossover(rng *rand.Rand, parent1, parent2 entity.Timetable) entity.Timetable {
+	if len(parent1.Classes) == 0 || len(parent2.Classes) == 0 {
 		return entity.Timetable{
 			Classes: []entity.ClassEntity{},
 		}
 	}
 
-	if len(parent1.Classes) == 0 || len(parent2.Classes) == 0 {
-		return entity.Timetable{
-			Classes: []entity.ClassEntity{},
+	keys1, sections1 := groupSections(parent1.Classes)
+	keys2, sections2 := groupSections(parent2.Classes)
+
+	child := entity.Timetable{}
+	for _, key := range keys1 {
+		classes := sections1[key]
+		if other, ok := sections2[key]; ok && rng.Intn(2) == 1 {
+			classes = other
 		}
+		child.Classes = append(child.Classes, classes...)
 	}
-
-	// Define the crossover point as the smaller size between the two parents
-	minLength := len(parent1.Classes)
-	if len(parent2.Classes) < minLength {
-		minLength = len(parent2.Classes)
+	for _, key := range keys2 {
+		if _, ok := sections1[key]; !ok {
+			child.Classes = append(child.Classes, sections2[key]...)
+		}
 	}
 
-	// Generate a valid crossing point
-	crossoverPoint := rand.Intn(minLength)
+	return child
+}
 
-	// Raising a child with cross-class backgrounds
-	child := entity.Timetable{}
-	child.Classes = append(child.Classes, parent1.Classes[:crossoverPoint]...)
-	child.Classes = append(child.Classes, parent2.Classes[crossoverPoint:]...)
+// sectionKey identifies a class section of a discipline.
+type sectionKey struct {
+	disciplineID int64
+	section      int32
+}
 
-	return child
+// groupSections groups the classes by section, returning the sections in the order they first appear.
+func groupSections(classes []entity.ClassEntity) ([]sectionKey, map[sectionKey][]entity.ClassEntity) {
+	var keys []sectionKey
+	sections := make(map[sectionKey][]entity.ClassEntity)
+	for _, class := range classes {
+		key := sectionKey{disciplineID: class.DisciplineID, section: class.Section}
+		if _, ok := sections[key]; !ok {
+			keys = append(keys, key)
+		}
+		sections[key] = append(sections[key], class)
+	}
+	return keys, sections
 }
 
-func Mutate(timetable *entity.Timetable, disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity) {
-	mutationRate := 0.01
-	for i := range timetable.Classes {
-		if rand.Float64() < mutationRate {
-			// Filter eligible teachers for the current subject
-			availableProfessors := FilterEligibleProfessors(timetable.Classes[i].DisciplineID, professors)
-			if len(availableProfessors) == 0 {
-				continue
-			}
+// Mutate hands a section, chosen with the mutation rate, to another eligible professor
+// and reschedules all of its blocks in that professor's availability. The section is
+// left untouched when the new professor has no room for it. Locked classes never move:
+// a section with any of them keeps its professor and only its other blocks are
+// rescheduled.
+func Mutate(rng *rand.Rand, timetable *entity.Timetable, disciplines []entity.DisciplineEntity, professors []entity.ProfessorEntity, availabilities []entity.AvailabilityEntity, parameterization entity.ParameterizationEntity, mutationRate float64) {
+	keys, sections := groupSections(timetable.Classes)
+	grid := TimeGrid(parameterization)
+	for _, key := range keys {
+		if rng.Float64() >= mutationRate {
+			continue
+		}
 
-			// Select a new teacher randomly
-			newProfessor := availableProfessors[rand.Intn(len(availableProfessors))]
+		discipline, ok := findDiscipline(key.disciplineID, disciplines)
+		if !ok {
+			continue
+		}
 
-			// Filter the availabilities of the new professor
-			availableSlots := FilterAvailableSlots(newProfessor.ID, availabilities)
-			if len(availableSlots) == 0 {
+		blocks := ClassBlocks(discipline, parameterization)
+		var newProfessor entity.ProfessorEntity
+		if locked, ok := lockedSections(sections[key], grid)[key]; ok {
+			blocks = remainingBlocks(blocks, locked.hours, parameterization)
+			newProfessor, ok = findProfessor(locked.professorID, professors)
+			if len(blocks) == 0 || !ok {
 				continue
 			}
-
-			// Find a time slot compatible with the shift and day and week of the original classroom
-			weekDay := timetable.Classes[i].StartTime // Setting the original day of the week
-			slot := findMatchingSlot(timetable.Classes[i].Shift, availableSlots, weekDay)
-			if slot == nil {
+		} else {
+			// Select a new teacher randomly among the eligible ones
+			availableProfessors := FilterEligibleProfessors(key.disciplineID, professors)
+			if len(availableProfessors) == 0 {
 				continue
 			}
+			newProfessor = availableProfessors[rng.Intn(len(availableProfessors))]
+		}
 
-			// Recalculate startTime and endTime based on new teacher availability
-			startTime, endTime := GenerateNextAvailableTime(weekDay, slot.Shift, nil, slot.DayOfWeek, timetable.Classes)
+		var others []entity.ClassEntity
+		for _, class := range timetable.Classes {
+			if class.Locked || class.DisciplineID != key.disciplineID || class.Section != key.section {
+				others = append(others, class)
+			}
+		}
 
-			// Check if the time is valid (it must not be zero)
-			zeroTime := time.Time{}
-			if startTime.Equal(zeroTime) {
-				continue
+		// Reschedule the section in every week it was given
+		var weeks []time.Time
+		seenWeeks := make(map[time.Time]bool)
+		for _, class := range sections[key] {
+			week := weekOf(class.StartTime)
+			if !seenWeeks[week] {
+				seenWeeks[week] = true
+				weeks = append(weeks, week)
+			}
+		}
+
+		var rescheduled []entity.ClassEntity
+		for _, week := range weeks {
+			classes, ok := scheduleSection(rng, discipline, key.section, blocks, newProfessor, availabilities, parameterization.Rooms, grid, week, append(others[:len(others):len(others)], rescheduled...))
+			if !ok {
+				rescheduled = nil
+				break
 			}
+			rescheduled = append(rescheduled, classes...)
+		}
+		if rescheduled == nil {
+			continue
+		}
+
+		timetable.Classes = append(others, rescheduled...)
+	}
+}
 
-			// Update the class in the timetable with the new teacher and recalculated times
-			timetable.Classes[i].ProfessorID = newProfessor.ID
-			timetable.Classes[i].StartTime = startTime
-			timetable.Classes[i].EndTime = endTime
+func findDiscipline(disciplineID int64, disciplines []entity.DisciplineEntity) (entity.DisciplineEntity, bool) {
+	for _, discipline := range disciplines {
+		if discipline.ID == disciplineID {
+			return discipline, true
 		}
 	}
+	return entity.DisciplineEntity{}, false
 }
 
 func ReplacePopulation(population, newPopulation []entity.Timetable) {
@@ -0,0 +1,337 @@
+package process
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func TestRunGeneticAlgorithmIsDeterministic(t *testing.T) {
+	disciplines := []entity.DisciplineEntity{
+		{ID: 1, Code: "ALG", Name: "Algorithms", Credits: 4, Term: 1},
+		{ID: 2, Code: "DB", Name: "Databases", Credits: 4, Term: 1},
+		{ID: 3, Code: "NET", Name: "Networks", Credits: 2, Term: 2},
+		{ID: 4, Code: "OS", Name: "Operating Systems", Credits: 4, Term: 2},
+	}
+	professors := []entity.ProfessorEntity{
+		{ID: 1, Name: "Ada", HoursToAllocate: 8, Disciplines: disciplines[:2]},
+		{ID: 2, Name: "Alan", HoursToAllocate: 6, Disciplines: disciplines[1:3]},
+		{ID: 3, Name: "Grace", HoursToAllocate: 8, Disciplines: disciplines[2:]},
+	}
+	var availabilities []entity.AvailabilityEntity
+	for _, professor := range professors {
+		for _, day := range []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"} {
+			availabilities = append(availabilities, entity.AvailabilityEntity{
+				DayOfWeek:   day,
+				StartTime:   clock(8, 0),
+				EndTime:     clock(12, 0),
+				ProfessorID: professor.ID,
+			}, entity.AvailabilityEntity{
+				DayOfWeek:   day,
+				StartTime:   clock(19, 0),
+				EndTime:     clock(23, 0),
+				ProfessorID: professor.ID,
+			})
+		}
+	}
+	parameterization := entity.ParameterizationEntity{
+		NumClassesPerDiscipline: 1,
+		PopulationSize:          20,
+		Generations:             30,
+		TournamentSize:          3,
+		MutationRate:            0.1,
+		Disciplines:             disciplines,
+		Professors:              professors,
+		Availabilities:          availabilities,
+	}
+
+	const seed = 42
+	first, err := RunGeneticAlgorithm(rand.New(rand.NewSource(seed)), disciplines, professors, availabilities, parameterization, 1, nil)
+	if err != nil {
+		t.Fatalf("RunGeneticAlgorithm() error = %v", err)
+	}
+	second, err := RunGeneticAlgorithm(rand.New(rand.NewSource(seed)), disciplines, professors, availabilities, parameterization, 1, nil)
+	if err != nil {
+		t.Fatalf("RunGeneticAlgorithm() error = %v", err)
+	}
+
+	if len(first.Classes) == 0 {
+		t.Fatal("expected the timetable to have classes")
+	}
+	if first.Fitness != second.Fitness {
+		t.Errorf("fitness differs between runs with the same seed: %v and %v", first.Fitness, second.Fitness)
+	}
+	if !reflect.DeepEqual(first.Classes, second.Classes) {
+		t.Errorf("classes differ between runs with the same seed:\n%+v\n%+v", first.Classes, second.Classes)
+	}
+}
+
+// weekAvailability makes the professors available on weekday mornings and nights.
+func weekAvailability(professors []entity.ProfessorEntity) []entity.AvailabilityEntity {
+	var availabilities []entity.AvailabilityEntity
+	for _, professor := range professors {
+		for _, day := range []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"} {
+			availabilities = append(availabilities, entity.AvailabilityEntity{
+				DayOfWeek:   day,
+				StartTime:   clock(8, 0),
+				EndTime:     clock(12, 0),
+				ProfessorID: professor.ID,
+			}, entity.AvailabilityEntity{
+				DayOfWeek:   day,
+				StartTime:   clock(19, 0),
+				EndTime:     clock(23, 0),
+				ProfessorID: professor.ID,
+			})
+		}
+	}
+	return availabilities
+}
+
+func TestGenerateRandomTimetableSections(t *testing.T) {
+	disciplines := []entity.DisciplineEntity{
+		{ID: 1, Code: "ALG", Name: "Algorithms", Credits: 4},
+		{ID: 2, Code: "NET", Name: "Networks", Credits: 2},
+	}
+	professors := []entity.ProfessorEntity{
+		{ID: 1, Name: "Ada", HoursToAllocate: 10, Disciplines: disciplines},
+		{ID: 2, Name: "Alan", HoursToAllocate: 10, Disciplines: disciplines},
+	}
+	parameterization := entity.ParameterizationEntity{
+		NumClassesPerDiscipline: 3,
+		Disciplines:             disciplines,
+		Professors:              professors,
+	}
+
+	timetable, err := GenerateRandomTimetable(rand.New(rand.NewSource(1)), disciplines, professors, weekAvailability(professors), 1, parameterization)
+	if err != nil {
+		t.Fatalf("GenerateRandomTimetable() error = %v", err)
+	}
+
+	professorOf := make(map[sectionKey]int64)
+	for _, class := range timetable.Classes {
+		key := sectionKey{disciplineID: class.DisciplineID, section: class.Section}
+		if professorID, ok := professorOf[key]; ok && professorID != class.ProfessorID {
+			t.Errorf("section %d of discipline %d has professors %d and %d", key.section, key.disciplineID, professorID, class.ProfessorID)
+		}
+		professorOf[key] = class.ProfessorID
+	}
+	for _, discipline := range disciplines {
+		for section := int32(1); section <= parameterization.NumClassesPerDiscipline; section++ {
+			if _, ok := professorOf[sectionKey{disciplineID: discipline.ID, section: section}]; !ok {
+				t.Errorf("discipline %s has no section %d", discipline.Code, section)
+			}
+		}
+	}
+	if want := len(disciplines) * int(parameterization.NumClassesPerDiscipline); len(professorOf) != want {
+		t.Errorf("timetable has %d sections, want %d", len(professorOf), want)
+	}
+	if violations := EvaluateSections(&timetable, parameterization); len(violations) > 0 {
+		t.Errorf("EvaluateSections() = %+v, want no violations", violations)
+	}
+}
+
+func TestEvaluateSections(t *testing.T) {
+	algorithms := entity.DisciplineEntity{ID: 1, Code: "ALG", Name: "Algorithms", Credits: 2}
+	parameterization := entity.ParameterizationEntity{
+		NumClassesPerDiscipline: 2,
+		Disciplines:             []entity.DisciplineEntity{algorithms},
+	}
+	class := func(section int32, day string, startHour, endHour int) entity.ClassEntity {
+		date, _ := dayOfWeekDate(referenceWeek, day)
+		return entity.ClassEntity{
+			DisciplineID: algorithms.ID,
+			Section:      section,
+			DayOfWeek:    day,
+			Shift:        "Morning",
+			StartTime:    date.Add(time.Duration(startHour) * time.Hour),
+			EndTime:      date.Add(time.Duration(endHour) * time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		classes []entity.ClassEntity
+		want    int
+	}{
+		{
+			name:    "every section with the hours of its credits",
+			classes: []entity.ClassEntity{class(1, "Monday", 8, 10), class(2, "Tuesday", 8, 10)},
+			want:    0,
+		},
+		{
+			name:    "missing section",
+			classes: []entity.ClassEntity{class(1, "Monday", 8, 10)},
+			want:    1,
+		},
+		{
+			name:    "excess section",
+			classes: []entity.ClassEntity{class(1, "Monday", 8, 10), class(2, "Tuesday", 8, 10), class(3, "Wednesday", 8, 10)},
+			want:    1,
+		},
+		{
+			name:    "section short of the hours of its credits",
+			classes: []entity.ClassEntity{class(1, "Monday", 8, 9), class(2, "Tuesday", 8, 10)},
+			want:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timetable := entity.Timetable{Classes: tt.classes}
+			if got := EvaluateSections(&timetable, parameterization); len(got) != tt.want {
+				t.Errorf("EvaluateSections() = %+v, want %d violations", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassBlocks(t *testing.T) {
+	tests := []struct {
+		name           string
+		credits        int32
+		hoursPerCredit int32
+		maxBlockHours  int32
+		want           []int
+	}{
+		{name: "defaults", credits: 4, want: []int{2, 2}},
+		{name: "odd hours leave a shorter last block", credits: 3, want: []int{2, 1}},
+		{name: "hours per credit", credits: 2, hoursPerCredit: 2, maxBlockHours: 4, want: []int{4}},
+		{name: "blocks no longer than the maximum", credits: 5, hoursPerCredit: 2, maxBlockHours: 4, want: []int{4, 4, 2}},
+		{name: "a discipline without credits still meets", credits: 0, want: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parameterization := entity.ParameterizationEntity{HoursPerCredit: tt.hoursPerCredit, MaxBlockHours: tt.maxBlockHours}
+			got := ClassBlocks(entity.DisciplineEntity{Credits: tt.credits}, parameterization)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClassBlocks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateRandomTimetableCreditBlocks(t *testing.T) {
+	disciplines := []entity.DisciplineEntity{
+		{ID: 1, Code: "ALG", Name: "Algorithms", Credits: 3},
+		{ID: 2, Code: "DB", Name: "Databases", Credits: 2},
+	}
+	professors := []entity.ProfessorEntity{{ID: 1, Name: "Ada", HoursToAllocate: 20, Disciplines: disciplines}}
+	parameterization := entity.ParameterizationEntity{
+		NumClassesPerDiscipline: 1,
+		HoursPerCredit:          2,
+		MaxBlockHours:           3,
+		Disciplines:             disciplines,
+		Professors:              professors,
+	}
+
+	timetable, err := GenerateRandomTimetable(rand.New(rand.NewSource(1)), disciplines, professors, weekAvailability(professors), 1, parameterization)
+	if err != nil {
+		t.Fatalf("GenerateRandomTimetable() error = %v", err)
+	}
+
+	grid := TimeGrid(parameterization)
+	blocks := make(map[int64][]int)
+	for _, class := range timetable.Classes {
+		blocks[class.DisciplineID] = append(blocks[class.DisciplineID], int(ClassHours(class, grid)))
+	}
+	for _, discipline := range disciplines {
+		got := blocks[discipline.ID]
+		sort.Sort(sort.Reverse(sort.IntSlice(got)))
+		if want := ClassBlocks(discipline, parameterization); !reflect.DeepEqual(got, want) {
+			t.Errorf("discipline %s has classes of %v hours, want %v", discipline.Code, got, want)
+		}
+	}
+}
+
+func TestEvaluateNoOverlapsSameTerm(t *testing.T) {
+	date, _ := dayOfWeekDate(referenceWeek, "Monday")
+	class := func(disciplineID int64, term, section int32, professorID int64, startHour int) entity.ClassEntity {
+		return entity.ClassEntity{
+			DisciplineID: disciplineID,
+			Section:      section,
+			ProfessorID:  professorID,
+			DayOfWeek:    "Monday",
+			Shift:        "Night",
+			StartTime:    date.Add(time.Duration(startHour) * time.Hour),
+			EndTime:      date.Add(time.Duration(startHour+2) * time.Hour),
+			Discipline:   &entity.DisciplineEntity{ID: disciplineID, Term: term},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		class1 entity.ClassEntity
+		class2 entity.ClassEntity
+		want   int
+	}{
+		{name: "same term and section at the same time", class1: class(1, 1, 1, 1, 19), class2: class(2, 1, 1, 2, 20), want: 1},
+		{name: "same term and section one after the other", class1: class(1, 1, 1, 1, 19), class2: class(2, 1, 1, 2, 21), want: 0},
+		{name: "same term in another section", class1: class(1, 1, 1, 1, 19), class2: class(2, 1, 2, 2, 19), want: 0},
+		{name: "another term", class1: class(1, 1, 1, 1, 19), class2: class(2, 2, 1, 2, 19), want: 0},
+		{name: "disciplines outside any term", class1: class(1, 0, 1, 1, 19), class2: class(2, 0, 1, 2, 19), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timetable := entity.Timetable{Classes: []entity.ClassEntity{tt.class1, tt.class2}}
+			if got := EvaluateNoOverlaps(&timetable); len(got) != tt.want {
+				t.Errorf("EvaluateNoOverlaps() = %+v, want %d violations", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateRandomTimetableSameTermClashes(t *testing.T) {
+	disciplines := []entity.DisciplineEntity{
+		{ID: 1, Code: "ALG", Name: "Algorithms", Credits: 4, Term: 1},
+		{ID: 2, Code: "DB", Name: "Databases", Credits: 4, Term: 1},
+		{ID: 3, Code: "NET", Name: "Networks", Credits: 4, Term: 2},
+	}
+	professors := []entity.ProfessorEntity{
+		{ID: 1, Name: "Ada", HoursToAllocate: 8, Disciplines: disciplines[:1]},
+		{ID: 2, Name: "Alan", HoursToAllocate: 8, Disciplines: disciplines[1:2]},
+		{ID: 3, Name: "Grace", HoursToAllocate: 8, Disciplines: disciplines[2:]},
+	}
+	// one shift a day for everyone, with a room each, so the only thing keeping classes
+	// apart is who attends them
+	var availabilities []entity.AvailabilityEntity
+	for _, professor := range professors {
+		for _, day := range []string{"Monday", "Tuesday"} {
+			availabilities = append(availabilities, entity.AvailabilityEntity{
+				DayOfWeek:   day,
+				StartTime:   clock(19, 0),
+				EndTime:     clock(23, 0),
+				ProfessorID: professor.ID,
+			})
+		}
+	}
+	parameterization := entity.ParameterizationEntity{
+		NumClassesPerDiscipline: 2,
+		Disciplines:             disciplines,
+		Professors:              professors,
+		Rooms: []entity.RoomEntity{
+			{ID: 1, Name: "Room 1", Capacity: 40},
+			{ID: 2, Name: "Room 2", Capacity: 40},
+			{ID: 3, Name: "Room 3", Capacity: 40},
+		},
+	}
+
+	for seed := int64(1); seed <= 20; seed++ {
+		timetable, err := GenerateRandomTimetable(rand.New(rand.NewSource(seed)), disciplines, professors, availabilities, 1, parameterization)
+		if err != nil {
+			t.Fatalf("GenerateRandomTimetable() error = %v", err)
+		}
+		for i, class1 := range timetable.Classes {
+			for _, class2 := range timetable.Classes[i+1:] {
+				if sameStudents(class1, class2) && class1.StartTime.Before(class2.EndTime) && class2.StartTime.Before(class1.EndTime) {
+					t.Errorf("seed %d: classes of disciplines %d and %d of the same term and section overlap", seed, class1.DisciplineID, class2.DisciplineID)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package process
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+// lockedSection is a section with locked classes: its professor and the weekly hours
+// the locked classes already take.
+type lockedSection struct {
+	professorID int64
+	hours       float64
+}
+
+// LockedClasses dates the classes locked on the parameterization in the week starting
+// at weekStart. They enter every timetable as they are and the genetic operators never
+// move them, so one that cannot be placed fails the generation.
+func LockedClasses(parameterization entity.ParameterizationEntity, disciplines []entity.DisciplineEntity, weekStart time.Time) ([]entity.ClassEntity, error) {
+	var classes []entity.ClassEntity
+	for _, locked := range parameterization.LockedClasses {
+		class, ok := PlaceClass(entity.ClassEntity{
+			Section:      locked.Section,
+			DisciplineID: locked.DisciplineID,
+			ProfessorID:  locked.ProfessorID,
+			RoomID:       locked.RoomID,
+			Locked:       true,
+		}, parameterization, weekStart, locked.DayOfWeek, locked.StartTime, locked.EndTime)
+		if !ok {
+			return nil, fmt.Errorf("locked class %s cannot be placed on %q", locked.UUID, locked.DayOfWeek)
+		}
+		if discipline, ok := findDiscipline(locked.DisciplineID, disciplines); ok {
+			class.Discipline = &discipline
+		}
+		classes = append(classes, class)
+	}
+	return classes, nil
+}
+
+// shiftAt names the shift of the period of the grid, or else of the shift hours, that
+// includes the time of day on the given weekday.
+func shiftAt(parameterization entity.ParameterizationEntity, grid []entity.TimeSlotEntity, dayOfWeek string, t time.Time) string {
+	offset := clockOffset(t)
+	for _, period := range periodsOn(grid, dayOfWeek) {
+		if offset >= clockOffset(period.StartTime) && offset < clockOffset(period.EndTime) {
+			return period.Shift
+		}
+	}
+	for _, shift := range ShiftHours(parameterization) {
+		if offset >= clockOffset(shift.StartTime) && offset < clockOffset(shift.EndTime) {
+			return shift.Shift
+		}
+	}
+	return ""
+}
+
+// lockedSections finds the sections with locked classes among the classes.
+func lockedSections(classes []entity.ClassEntity, grid []entity.TimeSlotEntity) map[sectionKey]lockedSection {
+	sections := make(map[sectionKey]lockedSection)
+	for _, class := range classes {
+		if !class.Locked {
+			continue
+		}
+		key := sectionKey{disciplineID: class.DisciplineID, section: class.Section}
+		section := sections[key]
+		section.professorID = class.ProfessorID
+		section.hours += ClassHours(class, grid)
+		sections[key] = section
+	}
+	return sections
+}
+
+// remainingBlocks splits the hours of a section its locked classes leave free into
+// blocks, as ClassBlocks does for a whole section.
+func remainingBlocks(blocks []int, lockedHours float64, parameterization entity.ParameterizationEntity) []int {
+	total := 0
+	for _, hours := range blocks {
+		total += hours
+	}
+	_, maxBlockHours := CreditHours(parameterization)
+	return splitHours(total-int(math.Round(lockedHours)), maxBlockHours)
+}
+
+func findProfessor(professorID int64, professors []entity.ProfessorEntity) (entity.ProfessorEntity, bool) {
+	for _, professor := range professors {
+		if professor.ID == professorID {
+			return professor, true
+		}
+	}
+	return entity.ProfessorEntity{}, false
+}
@@ -0,0 +1,118 @@
+package process
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func TestLockedClasses(t *testing.T) {
+	algorithms := entity.DisciplineEntity{ID: 1, Code: "ALG", Name: "Algorithms", Credits: 4}
+	locked := entity.LockedClassEntity{
+		UUID:         uuid.New(),
+		DisciplineID: algorithms.ID,
+		ProfessorID:  2,
+		RoomID:       3,
+		Section:      1,
+		DayOfWeek:    "Wednesday",
+		StartTime:    clock(19, 0),
+		EndTime:      clock(21, 0),
+	}
+
+	classes, err := LockedClasses(entity.ParameterizationEntity{LockedClasses: []entity.LockedClassEntity{locked}}, []entity.DisciplineEntity{algorithms}, referenceWeek)
+	if err != nil {
+		t.Fatalf("LockedClasses() error = %v", err)
+	}
+	if len(classes) != 1 {
+		t.Fatalf("LockedClasses() returned %d classes, want 1", len(classes))
+	}
+
+	class := classes[0]
+	wednesday := referenceWeek.AddDate(0, 0, 2)
+	if want := wednesday.Add(19 * time.Hour); !class.StartTime.Equal(want) {
+		t.Errorf("class starts at %v, want %v", class.StartTime, want)
+	}
+	if want := wednesday.Add(21 * time.Hour); !class.EndTime.Equal(want) {
+		t.Errorf("class ends at %v, want %v", class.EndTime, want)
+	}
+	if class.DayOfWeek != "Wednesday" || class.Shift != "Night" {
+		t.Errorf("class is on %s %s, want Wednesday Night", class.DayOfWeek, class.Shift)
+	}
+	if !class.Locked || class.DisciplineID != algorithms.ID || class.ProfessorID != 2 || class.RoomID != 3 || class.Section != 1 {
+		t.Errorf("class = %+v, want the locked class", class)
+	}
+	if class.Discipline == nil || class.Discipline.ID != algorithms.ID {
+		t.Errorf("class discipline = %+v, want %s", class.Discipline, algorithms.Code)
+	}
+
+	locked.DayOfWeek = "Sunday"
+	if _, err := LockedClasses(entity.ParameterizationEntity{LockedClasses: []entity.LockedClassEntity{locked}}, []entity.DisciplineEntity{algorithms}, referenceWeek); err == nil {
+		t.Errorf("LockedClasses() error = nil for a class locked on Sunday")
+	}
+}
+
+func TestRunGeneticAlgorithmKeepsLockedClasses(t *testing.T) {
+	disciplines := []entity.DisciplineEntity{
+		{ID: 1, Code: "ALG", Name: "Algorithms", Credits: 4},
+		{ID: 2, Code: "DB", Name: "Databases", Credits: 4},
+	}
+	professors := []entity.ProfessorEntity{
+		{ID: 1, Name: "Ada", HoursToAllocate: 8, Disciplines: disciplines},
+		{ID: 2, Name: "Alan", HoursToAllocate: 8, Disciplines: disciplines},
+	}
+	locked := entity.LockedClassEntity{
+		UUID:         uuid.New(),
+		DisciplineID: 1,
+		ProfessorID:  2,
+		Section:      1,
+		DayOfWeek:    "Wednesday",
+		StartTime:    clock(19, 0),
+		EndTime:      clock(21, 0),
+	}
+	parameterization := entity.ParameterizationEntity{
+		NumClassesPerDiscipline: 1,
+		PopulationSize:          10,
+		Generations:             10,
+		TournamentSize:          3,
+		MutationRate:            1,
+		Disciplines:             disciplines,
+		Professors:              professors,
+		LockedClasses:           []entity.LockedClassEntity{locked},
+	}
+	want, err := LockedClasses(parameterization, disciplines, referenceWeek)
+	if err != nil {
+		t.Fatalf("LockedClasses() error = %v", err)
+	}
+
+	// every section is mutated in every generation, so only locking keeps the class in place
+	best, err := RunGeneticAlgorithm(rand.New(rand.NewSource(1)), disciplines, professors, weekAvailability(professors), parameterization, 1, nil)
+	if err != nil {
+		t.Fatalf("RunGeneticAlgorithm() error = %v", err)
+	}
+
+	var lockedClasses, sectionHours int
+	grid := TimeGrid(parameterization)
+	for _, class := range best.Classes {
+		if class.Locked {
+			lockedClasses++
+			if !class.StartTime.Equal(want[0].StartTime) || !class.EndTime.Equal(want[0].EndTime) || class.ProfessorID != locked.ProfessorID {
+				t.Errorf("locked class moved to %+v", class)
+			}
+		}
+		if class.DisciplineID == locked.DisciplineID && class.Section == locked.Section {
+			sectionHours += int(ClassHours(class, grid))
+			if class.ProfessorID != locked.ProfessorID {
+				t.Errorf("class of the locked section is taught by professor %d, want %d", class.ProfessorID, locked.ProfessorID)
+			}
+		}
+	}
+	if lockedClasses != 1 {
+		t.Errorf("timetable has %d locked classes, want 1", lockedClasses)
+	}
+	if sectionHours != 4 {
+		t.Errorf("locked section has %d hours, want the 4 of its credits", sectionHours)
+	}
+}
@@ -0,0 +1,74 @@
+package process
+
+import (
+	"sort"
+	"time"
+
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+// DefaultShifts are the hours of the shifts no course, location or institution-wide
+// configuration overrides.
+var DefaultShifts = []entity.ShiftHoursEntity{
+	{Shift: "Morning", StartTime: clock(8, 0), EndTime: clock(12, 0)},
+	{Shift: "Afternoon", StartTime: clock(13, 0), EndTime: clock(18, 0)},
+	{Shift: "Night", StartTime: clock(19, 0), EndTime: clock(23, 0)},
+}
+
+func clock(hour, minute int) time.Time {
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC)
+}
+
+// ResolveShifts merges the configured shift hours of a course into the defaults. For
+// each shift the hours of the course win over the ones of its location, which win over
+// the institution-wide ones; shifts that are not among the defaults are added. The
+// shifts are returned in the order they start.
+func ResolveShifts(configured []entity.ShiftHoursEntity) []entity.ShiftHoursEntity {
+	specificity := func(shift entity.ShiftHoursEntity) int {
+		switch {
+		case shift.CourseID > 0:
+			return 3
+		case shift.Location != "":
+			return 2
+		}
+		return 1
+	}
+
+	resolved := make(map[string]entity.ShiftHoursEntity)
+	level := make(map[string]int)
+	for _, shift := range DefaultShifts {
+		resolved[shift.Shift] = shift
+	}
+	for _, shift := range configured {
+		if specificity(shift) > level[shift.Shift] {
+			resolved[shift.Shift] = shift
+			level[shift.Shift] = specificity(shift)
+		}
+	}
+
+	shifts := make([]entity.ShiftHoursEntity, 0, len(resolved))
+	for _, shift := range resolved {
+		shifts = append(shifts, shift)
+	}
+	sort.Slice(shifts, func(i, j int) bool {
+		if clockOffset(shifts[i].StartTime) != clockOffset(shifts[j].StartTime) {
+			return clockOffset(shifts[i].StartTime) < clockOffset(shifts[j].StartTime)
+		}
+		return shifts[i].Shift < shifts[j].Shift
+	})
+	return shifts
+}
+
+// ShiftHours returns the shifts the parameterization schedules in, the defaults when
+// none were resolved for it.
+func ShiftHours(parameterization entity.ParameterizationEntity) []entity.ShiftHoursEntity {
+	if len(parameterization.Shifts) == 0 {
+		return DefaultShifts
+	}
+	return parameterization.Shifts
+}
+
+// clockOffset is the time of day of t as an offset from midnight.
+func clockOffset(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
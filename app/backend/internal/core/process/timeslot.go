@@ -0,0 +1,152 @@
+package process
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+// Shifts are the shifts classes can be given in.
+var Shifts = []string{"Morning", "Afternoon", "Night"}
+
+// ScheduleWeekdays are the days classes can be given on, in week order. Saturday is
+// only used by the time slots that list it.
+var ScheduleWeekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// TimeGrid returns the periods the parameterization schedules classes on: the time
+// slots of the institution or, when none are configured, periods of an hour over the
+// hours of each shift.
+func TimeGrid(parameterization entity.ParameterizationEntity) []entity.TimeSlotEntity {
+	if len(parameterization.TimeSlots) > 0 {
+		return parameterization.TimeSlots
+	}
+
+	var grid []entity.TimeSlotEntity
+	for _, shift := range ShiftHours(parameterization) {
+		end := clockOffset(shift.EndTime)
+		for start, period := clockOffset(shift.StartTime), 1; start+time.Hour <= end; start, period = start+time.Hour, period+1 {
+			grid = append(grid, entity.TimeSlotEntity{
+				Label:     fmt.Sprintf("%s %d", shift.Shift, period),
+				Shift:     shift.Shift,
+				StartTime: clock(0, 0).Add(start),
+				EndTime:   clock(0, 0).Add(start + time.Hour),
+			})
+		}
+	}
+	return grid
+}
+
+// weekdays are the days a time slot that does not list its own applies to.
+var weekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}
+
+// SlotWeekdays are the days a time slot applies to.
+func SlotWeekdays(slot entity.TimeSlotEntity) []string {
+	if len(slot.Weekdays) == 0 {
+		return weekdays
+	}
+	return slot.Weekdays
+}
+
+// ScheduleDays are the days, in week order, at least one period of the grid applies to.
+func ScheduleDays(grid []entity.TimeSlotEntity) []string {
+	var days []string
+	for _, day := range ScheduleWeekdays {
+		for _, slot := range grid {
+			if slices.Contains(SlotWeekdays(slot), day) {
+				days = append(days, day)
+				break
+			}
+		}
+	}
+	return days
+}
+
+// periodsOn returns the periods of the grid given on the day, in the order they start.
+func periodsOn(grid []entity.TimeSlotEntity, dayOfWeek string) []entity.TimeSlotEntity {
+	var periods []entity.TimeSlotEntity
+	for _, slot := range grid {
+		if slices.Contains(SlotWeekdays(slot), dayOfWeek) {
+			periods = append(periods, slot)
+		}
+	}
+	sort.SliceStable(periods, func(i, j int) bool {
+		return clockOffset(periods[i].StartTime) < clockOffset(periods[j].StartTime)
+	})
+	return periods
+}
+
+// timeWindow is a stretch of a day, as offsets from midnight, inside a single shift.
+type timeWindow struct {
+	shift      string
+	start, end time.Duration
+}
+
+// periodRuns lists the stretches of the day made of the given number of consecutive
+// periods of the same shift, in the order they start. Breaks between the periods of a
+// shift do not split a run, as a class goes on after them.
+func periodRuns(grid []entity.TimeSlotEntity, dayOfWeek string, periods int) []timeWindow {
+	byShift := make(map[string][]entity.TimeSlotEntity)
+	for _, slot := range periodsOn(grid, dayOfWeek) {
+		byShift[slot.Shift] = append(byShift[slot.Shift], slot)
+	}
+
+	var runs []timeWindow
+	for _, shift := range Shifts {
+		slots := byShift[shift]
+		for i := 0; i+periods <= len(slots); i++ {
+			runs = append(runs, timeWindow{
+				shift: shift,
+				start: clockOffset(slots[i].StartTime),
+				end:   clockOffset(slots[i+periods-1].EndTime),
+			})
+		}
+	}
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].start < runs[j].start })
+	return runs
+}
+
+// NextAvailablePeriods returns a class on the first run of the given number of
+// consecutive periods that falls inside the availability and does not overlap the
+// classes already scheduled that day. It fails when no run fits.
+func NextAvailablePeriods(weekDay time.Time, availability entity.AvailabilityEntity, grid []entity.TimeSlotEntity, classes []entity.ClassEntity, periods int) (entity.ClassEntity, bool) {
+	day := time.Date(weekDay.Year(), weekDay.Month(), weekDay.Day(), 0, 0, 0, 0, weekDay.Location())
+	for _, run := range periodRuns(grid, availability.DayOfWeek, periods) {
+		if run.start < clockOffset(availability.StartTime) || run.end > clockOffset(availability.EndTime) {
+			continue
+		}
+		startTime, endTime := day.Add(run.start), day.Add(run.end)
+		if isClassScheduled(classes, availability.DayOfWeek, startTime, endTime) {
+			continue
+		}
+		return entity.ClassEntity{
+			DayOfWeek: availability.DayOfWeek,
+			Shift:     run.shift,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}, true
+	}
+	return entity.ClassEntity{}, false
+}
+
+// ClassHours is the number of class hours a class stands for: the periods of the grid
+// it takes or, for a class that does not start and end with periods, its length in hours.
+func ClassHours(class entity.ClassEntity, grid []entity.TimeSlotEntity) float64 {
+	start, end := clockOffset(class.StartTime), clockOffset(class.EndTime)
+	startsPeriod, endsPeriod := false, false
+	periods := 0
+	for _, slot := range periodsOn(grid, class.DayOfWeek) {
+		slotStart, slotEnd := clockOffset(slot.StartTime), clockOffset(slot.EndTime)
+		startsPeriod = startsPeriod || slotStart == start
+		endsPeriod = endsPeriod || slotEnd == end
+		if slotStart >= start && slotEnd <= end {
+			periods++
+		}
+	}
+	if !startsPeriod || !endsPeriod {
+		return class.EndTime.Sub(class.StartTime).Hours()
+	}
+	return float64(periods)
+}
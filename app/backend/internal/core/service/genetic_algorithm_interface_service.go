@@ -3,10 +3,22 @@ package service
 import (
 	"context"
 	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
 	"github.com/robinsonvs/time-table-project/internal/repository/availabilityrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/curriculummatrixrepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/disciplinerepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/lockedclassrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationconstraintrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationdisciplinerepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationrepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/professorrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/proposaljobrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/proposalrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/roomrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/semesterrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/shifthoursrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/timeslotrepository"
 )
 
 func NewGeneticAlgorithmService(
@@ -14,22 +26,63 @@ func NewGeneticAlgorithmService(
 	professorRepo professorrepository.ProfessorRepository,
 	availabilityRepo availabilityrepository.AvailabilityRepository,
 	parameterizationRepo parameterizationrepository.ParameterizationRepository,
+	proposalJobRepo proposaljobrepository.ProposalJobRepository,
+	parameterizationDisciplineRepo parameterizationdisciplinerepository.ParameterizationDisciplineRepository,
+	roomRepo roomrepository.RoomRepository,
+	parameterizationConstraintRepo parameterizationconstraintrepository.ParameterizationConstraintRepository,
+	curriculumMatrixRepo curriculummatrixrepository.CurriculumMatrixRepository,
+	shiftHoursRepo shifthoursrepository.ShiftHoursRepository,
+	timeSlotRepo timeslotrepository.TimeSlotRepository,
+	semesterRepo semesterrepository.SemesterRepository,
+	lockedClassRepo lockedclassrepository.LockedClassRepository,
+	proposalRepo proposalrepository.ProposalRepository,
 ) GeneticAlgorithmServiceInterface {
 	return &GeneticAlgorithmService{
-		DisciplineRepo:       disciplineRepo,
-		ProfessorRepo:        professorRepo,
-		AvailabilityRepo:     availabilityRepo,
-		ParameterizationRepo: parameterizationRepo,
+		DisciplineRepo:                 disciplineRepo,
+		ProfessorRepo:                  professorRepo,
+		AvailabilityRepo:               availabilityRepo,
+		ParameterizationRepo:           parameterizationRepo,
+		ProposalJobRepo:                proposalJobRepo,
+		ParameterizationDisciplineRepo: parameterizationDisciplineRepo,
+		RoomRepo:                       roomRepo,
+		ParameterizationConstraintRepo: parameterizationConstraintRepo,
+		CurriculumMatrixRepo:           curriculumMatrixRepo,
+		ShiftHoursRepo:                 shiftHoursRepo,
+		TimeSlotRepo:                   timeSlotRepo,
+		SemesterRepo:                   semesterRepo,
+		LockedClassRepo:                lockedClassRepo,
+		ProposalRepo:                   proposalRepo,
+		proposalJobQueued:              make(chan struct{}, 1),
 	}
 }
 
 type GeneticAlgorithmService struct {
-	DisciplineRepo       disciplinerepository.DisciplineRepository
-	ProfessorRepo        professorrepository.ProfessorRepository
-	AvailabilityRepo     availabilityrepository.AvailabilityRepository
-	ParameterizationRepo parameterizationrepository.ParameterizationRepository
+	DisciplineRepo                 disciplinerepository.DisciplineRepository
+	ProfessorRepo                  professorrepository.ProfessorRepository
+	AvailabilityRepo               availabilityrepository.AvailabilityRepository
+	ParameterizationRepo           parameterizationrepository.ParameterizationRepository
+	ProposalJobRepo                proposaljobrepository.ProposalJobRepository
+	ParameterizationDisciplineRepo parameterizationdisciplinerepository.ParameterizationDisciplineRepository
+	RoomRepo                       roomrepository.RoomRepository
+	ParameterizationConstraintRepo parameterizationconstraintrepository.ParameterizationConstraintRepository
+	CurriculumMatrixRepo           curriculummatrixrepository.CurriculumMatrixRepository
+	ShiftHoursRepo                 shifthoursrepository.ShiftHoursRepository
+	TimeSlotRepo                   timeslotrepository.TimeSlotRepository
+	SemesterRepo                   semesterrepository.SemesterRepository
+	LockedClassRepo                lockedclassrepository.LockedClassRepository
+	ProposalRepo                   proposalrepository.ProposalRepository
+	// proposalJobQueued wakes an idle worker when a job is queued
+	proposalJobQueued chan struct{}
 }
 
 type GeneticAlgorithmServiceInterface interface {
-	GenerateProposal(ctx context.Context, parameterizationID uuid.UUID) error
+	GenerateProposal(ctx context.Context, parameterizationID uuid.UUID, u dto.GenerateProposalDto) (*response.ProposalJobResponse, error)
+	GetProposalJobByID(ctx context.Context, uuid uuid.UUID) (*response.ProposalJobResponse, error)
+	FindManyProposalJobs(ctx context.Context) (*response.ManyProposalJobsResponse, error)
+	FindManyProposalJobsByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyProposalJobsResponse, error)
+	ResumeProposalJobs(ctx context.Context) error
+	StartProposalJobWorkers()
+	CreateProposalClass(ctx context.Context, proposalUUID, userUUID uuid.UUID, u dto.CreateProposalClassDto) (*response.ProposalEditResponse, error)
+	UpdateProposalClass(ctx context.Context, proposalUUID, classUUID, userUUID uuid.UUID, u dto.UpdateProposalClassDto) (*response.ProposalEditResponse, error)
+	DeleteProposalClass(ctx context.Context, proposalUUID, classUUID, userUUID uuid.UUID, force bool, reason string) (*response.ProposalEditResponse, error)
 }
@@ -2,54 +2,375 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/dto"
 	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"math/rand"
+	"time"
 )
 
-func (s *GeneticAlgorithmService) GenerateProposal(ctx context.Context, parameterizationID uuid.UUID) error {
+// proposalJobWorkers is how many proposals are generated at the same time. Further jobs
+// wait in the queue as pending until a worker is free.
+const proposalJobWorkers = 2
+
+// proposalJobPollInterval is how often an idle worker looks for pending jobs it was not
+// woken up for, such as the ones queued while the database could not be reached.
+const proposalJobPollInterval = 30 * time.Second
+
+func (s *GeneticAlgorithmService) GenerateProposal(ctx context.Context, parameterizationID uuid.UUID, u dto.GenerateProposalDto) (*response.ProposalJobResponse, error) {
 	parameterization, err := s.ParameterizationRepo.FindParameterizationByID(ctx, parameterizationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("parameterization not found", slog.String("package", "geneticalgorithmservice"))
+			return nil, errors.New("parameterization not found")
+		}
+		slog.Error("error to search parameterization by id", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	seed := time.Now().UnixNano()
+	if u.Seed != nil {
+		seed = *u.Seed
+	}
+
+	job := entity.ProposalJobEntity{
+		UUID:                 uuid.New(),
+		Status:               entity.ProposalJobStatusPending,
+		Seed:                 seed,
+		ParameterizationID:   parameterization.ID,
+		ParameterizationUUID: parameterization.UUID,
+	}
+
+	err = s.ProposalJobRepo.CreateProposalJob(ctx, &job)
+	if err != nil {
+		slog.Error("error to create proposal job", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	s.notifyProposalJobWorkers()
+
+	return s.GetProposalJobByID(ctx, job.UUID)
+}
+
+func (s *GeneticAlgorithmService) GetProposalJobByID(ctx context.Context, uuid uuid.UUID) (*response.ProposalJobResponse, error) {
+	job, err := s.ProposalJobRepo.FindProposalJobByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal job not found", slog.String("package", "geneticalgorithmservice"))
+			return nil, errors.New("proposal job not found")
+		}
+		slog.Error("error to search proposal job by id", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	res := toProposalJobResponse(*job)
+	return &res, nil
+}
+
+func (s *GeneticAlgorithmService) FindManyProposalJobs(ctx context.Context) (*response.ManyProposalJobsResponse, error) {
+	jobs, err := s.ProposalJobRepo.FindManyProposalJobs(ctx)
+	if err != nil {
+		slog.Error("error to find many proposal jobs", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	res := response.ManyProposalJobsResponse{}
+	for _, job := range jobs {
+		res.ProposalJobs = append(res.ProposalJobs, toProposalJobResponse(job))
+	}
+
+	return &res, nil
+}
+
+func (s *GeneticAlgorithmService) FindManyProposalJobsByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyProposalJobsResponse, error) {
+	jobs, err := s.ProposalJobRepo.FindManyProposalJobsByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		slog.Error("error to find many proposal jobs", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	res := response.ManyProposalJobsResponse{}
+	for _, job := range jobs {
+		res.ProposalJobs = append(res.ProposalJobs, toProposalJobResponse(job))
+	}
+
+	return &res, nil
+}
+
+// ResumeProposalJobs is called on boot, before the workers start. Jobs that were
+// running when the server stopped are marked as failed; jobs that never started are
+// left pending for the workers.
+func (s *GeneticAlgorithmService) ResumeProposalJobs(ctx context.Context) error {
+	interrupted, err := s.ProposalJobRepo.FindManyProposalJobsByStatus(ctx, entity.ProposalJobStatusRunning)
 	if err != nil {
 		return err
 	}
+	for _, job := range interrupted {
+		job.Status = entity.ProposalJobStatusFailed
+		job.Error = "interrupted by server restart"
+		err = s.ProposalJobRepo.FinishProposalJob(ctx, &job)
+		if err != nil {
+			slog.Error(fmt.Sprintf("error to mark proposal job %s as failed", job.UUID), "err", err, slog.String("package", "geneticalgorithmservice"))
+			continue
+		}
+		slog.Info(fmt.Sprintf("proposal job %s marked as failed", job.UUID), slog.String("package", "geneticalgorithmservice"))
+	}
+
+	return nil
+}
+
+// StartProposalJobWorkers starts the workers running the pending jobs. It is called on
+// boot after ResumeProposalJobs, so none of the jobs they claim is taken for an
+// interrupted one, and whether or not that succeeded.
+func (s *GeneticAlgorithmService) StartProposalJobWorkers() {
+	for i := 0; i < proposalJobWorkers; i++ {
+		go s.runProposalJobWorker()
+	}
+}
+
+// runProposalJobWorker runs the pending jobs one at a time, oldest first, and waits for
+// new ones when the queue is empty.
+func (s *GeneticAlgorithmService) runProposalJobWorker() {
+	ctx := context.Background()
+	for {
+		job, err := s.ProposalJobRepo.ClaimProposalJob(ctx)
+		if err != nil {
+			if err != sql.ErrNoRows {
+				slog.Error("error to claim proposal job", "err", err, slog.String("package", "geneticalgorithmservice"))
+			}
+			select {
+			case <-s.proposalJobQueued:
+			case <-time.After(proposalJobPollInterval):
+			}
+			continue
+		}
+
+		// more jobs may be waiting for another idle worker
+		s.notifyProposalJobWorkers()
+		s.runProposalJob(*job)
+	}
+}
+
+// notifyProposalJobWorkers wakes an idle worker, if there is one, to look for pending jobs.
+func (s *GeneticAlgorithmService) notifyProposalJobWorkers() {
+	select {
+	case s.proposalJobQueued <- struct{}{}:
+	default:
+	}
+}
+
+func (s *GeneticAlgorithmService) runProposalJob(job entity.ProposalJobEntity) {
+	ctx := context.Background()
+
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("proposal job %s panicked: %v", job.UUID, r), slog.String("package", "geneticalgorithmservice"))
+			job.Status = entity.ProposalJobStatusFailed
+			job.Error = fmt.Sprintf("%v", r)
+			if err := s.ProposalJobRepo.FinishProposalJob(ctx, &job); err != nil {
+				slog.Error("error to finish proposal job", "err", err, slog.String("package", "geneticalgorithmservice"))
+			}
+		}
+	}()
+
+	onProgress := func(done, total int) {
+		progress := int32(done * 100 / total)
+		if progress == job.Progress {
+			return
+		}
+		job.Progress = progress
+		if err := s.ProposalJobRepo.UpdateProposalJobProgress(ctx, job.UUID, progress); err != nil {
+			slog.Error("error to update proposal job progress", "err", err, slog.String("package", "geneticalgorithmservice"))
+		}
+	}
+
+	proposal, err := s.generateProposal(ctx, job.ParameterizationUUID, job.Seed, onProgress)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to generate a proposal: %v", err), slog.String("package", "geneticalgorithmservice"))
+		job.Status = entity.ProposalJobStatusFailed
+		job.Error = err.Error()
+	} else {
+		job.Status = entity.ProposalJobStatusCompleted
+		job.Progress = 100
+		job.ProposalID = proposal.ID
+	}
+
+	err = s.ProposalJobRepo.FinishProposalJob(ctx, &job)
+	if err != nil {
+		slog.Error("error to finish proposal job", "err", err, slog.String("package", "geneticalgorithmservice"))
+	}
+}
 
+func (s *GeneticAlgorithmService) generateProposal(ctx context.Context, parameterizationID uuid.UUID, seed int64, onProgress func(done, total int)) (*entity.ProposalEntity, error) {
+	parameterization, err := s.ParameterizationRepo.FindParameterizationByID(ctx, parameterizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	disciplines, professors, availabilities, err := s.loadParameterization(ctx, parameterization)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	bestTimetable, err := process.RunGeneticAlgorithm(rng, disciplines, professors, availabilities, *parameterization, 1, onProgress)
+	if err != nil {
+		return nil, err
+	}
+	if len(bestTimetable.Classes) == 0 {
+		return nil, errors.New("no classes could be generated for this parameterization")
+	}
+
+	populationSize, generations, tournamentSize, mutationRate := process.Hyperparameters(*parameterization)
+	proposal := &entity.ProposalEntity{
+		SemesterID:         parameterization.SemesterID,
+		CourseID:           parameterization.CourseID,
+		Seed:               seed,
+		Fitness:            bestTimetable.Fitness,
+		FitnessBreakdown:   bestTimetable.Breakdown,
+		ParameterizationID: parameterization.ID,
+		PopulationSize:     int32(populationSize),
+		Generations:        int32(generations),
+		TournamentSize:     int32(tournamentSize),
+		MutationRate:       mutationRate,
+		Classes:            bestTimetable.Classes,
+	}
+
+	// the generated classes are the first version of the proposal
+	err = s.ProposalRepo.CreateProposal(ctx, proposal, &entity.ProposalVersionEntity{
+		UUID:   uuid.New(),
+		Reason: "generated",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// loadParameterization gathers everything the timetables of the parameterization are
+// built and scored with: the disciplines it offers, the eligible professors and their
+// availability, rooms, constraints, shifts, time grid, semester calendar and locked
+// classes.
+func (s *GeneticAlgorithmService) loadParameterization(ctx context.Context, parameterization *entity.ParameterizationEntity) ([]entity.DisciplineEntity, []entity.ProfessorEntity, []entity.AvailabilityEntity, error) {
+	var err error
 	parameterization.Disciplines, err = s.ParameterizationRepo.GetDisciplinesByCourseID(ctx, parameterization.CourseID)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	parameterization.Professors, err = s.ParameterizationRepo.GetProfessorsByCourseID(ctx, parameterization.CourseID)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	disciplines, err := s.DisciplineRepo.FindManyDisciplinesByCoarseId(ctx, parameterization.CourseID)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
-	professors, err := s.ProfessorRepo.GetProfessorsWithDisciplines(ctx)
+	// with an active curriculum matrix only the disciplines of that version are candidates,
+	// in the terms the matrix places them
+	curriculum, err := s.CurriculumMatrixRepo.FindActiveCurriculumMatrixDisciplinesByCourseId(ctx, parameterization.CourseID)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
+	}
+	if len(curriculum) > 0 {
+		disciplines = process.CurriculumDisciplines(curriculum)
+		parameterization.Disciplines = disciplines
 	}
 
-	availabilities, err := s.AvailabilityRepo.FindManyAvailabilities(ctx)
+	// when the parameterization chooses its disciplines only those are scheduled, otherwise the
+	// matrix decides which ones are offered and, without one, every discipline of the course is a candidate
+	offered, err := s.ParameterizationDisciplineRepo.FindManyParameterizationDisciplinesByParameterizationId(ctx, parameterization.ID)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
+	}
+	if len(offered) == 0 {
+		offered = process.CurriculumOffer(curriculum)
+	}
+	if len(offered) > 0 {
+		disciplines = process.SelectDisciplinesToOffer(disciplines, offered, parameterization.MaxCreditsToOffer)
+		parameterization.Disciplines = disciplines
 	}
 
-	bestTimetable := process.RunGeneticAlgorithm(disciplines, professors, availabilities, *parameterization, 1)
+	// rooms at the course location; without any registered the classes are scheduled without a room
+	parameterization.Rooms, err = s.RoomRepo.FindManyRoomsByCourseId(ctx, parameterization.CourseID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-	proposal := &entity.ProposalEntity{
-		SemesterID: parameterization.SemesterID,
-		CourseID:   parameterization.CourseID,
-		Classes:    bestTimetable.Classes,
+	// constraints the parameterization disables or weighs differently; the others are scored with their defaults
+	parameterization.Constraints, err = s.ParameterizationConstraintRepo.FindManyParameterizationConstraintsByParameterizationId(ctx, parameterization.ID)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	err = s.ParameterizationRepo.CreateProposal(ctx, proposal)
+	// hours of each shift for the course, the most specific configuration winning over the defaults
+	shifts, err := s.ShiftHoursRepo.FindShiftHoursForCourse(ctx, parameterization.CourseID)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
+	parameterization.Shifts = process.ResolveShifts(shifts)
 
-	return nil
+	// periods of the institution; without any the classes take whole hours of the shifts
+	parameterization.TimeSlots, err = s.TimeSlotRepo.FindManyTimeSlots(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// classes are dated in the first week of the semester, when its calendar is set
+	semester, err := s.SemesterRepo.FindSemesterBySemesterId(ctx, parameterization.SemesterID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	parameterization.SemesterStart = semester.StartDate
+
+	// classes placed by hand, which every timetable keeps as they are
+	parameterization.LockedClasses, err = s.LockedClassRepo.FindManyLockedClassesByParameterizationId(ctx, parameterization.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	professors, err := s.ProfessorRepo.GetProfessorsWithDisciplines(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	availabilities, err := s.AvailabilityRepo.FindManyAvailabilities(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	parameterization.Availabilities = availabilities
+
+	return disciplines, professors, availabilities, nil
+}
+
+func toProposalJobResponse(job entity.ProposalJobEntity) response.ProposalJobResponse {
+	res := response.ProposalJobResponse{
+		Id:                   job.ID,
+		UUID:                 job.UUID.String(),
+		Status:               job.Status,
+		Progress:             job.Progress,
+		Error:                job.Error,
+		CreatedAt:            job.CreatedAt,
+		Seed:                 job.Seed,
+		ParameterizationUUID: job.ParameterizationUUID.String(),
+	}
+	if !job.StartedAt.IsZero() {
+		res.StartedAt = &job.StartedAt
+	}
+	if !job.FinishedAt.IsZero() {
+		res.FinishedAt = &job.FinishedAt
+	}
+	if job.ProposalUUID != uuid.Nil {
+		res.ProposalUUID = job.ProposalUUID.String()
+	}
+	return res
 }
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"time"
+)
+
+// timeOfDayLayout is how the start and end of edited classes are written.
+const timeOfDayLayout = "15:04"
+
+// proposalEdit is a proposal with its classes scored against its parameterization, the
+// timetable every edit is compared with.
+type proposalEdit struct {
+	proposal         *entity.ProposalEntity
+	parameterization *entity.ParameterizationEntity
+	disciplines      []entity.DisciplineEntity
+	professors       []entity.ProfessorEntity
+	original         entity.Timetable
+}
+
+func (s *GeneticAlgorithmService) CreateProposalClass(ctx context.Context, proposalUUID, userUUID uuid.UUID, u dto.CreateProposalClassDto) (*response.ProposalEditResponse, error) {
+	startTime, err := time.Parse(timeOfDayLayout, u.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := time.Parse(timeOfDayLayout, u.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !endTime.After(startTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+
+	edit, err := s.loadProposalEdit(ctx, proposalUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var discipline *entity.DisciplineEntity
+	for i := range edit.disciplines {
+		if edit.disciplines[i].ID == u.DisciplineId {
+			discipline = &edit.disciplines[i]
+			break
+		}
+	}
+	if discipline == nil {
+		slog.Error("discipline is not offered by the parameterization", slog.String("package", "geneticalgorithmservice"))
+		return nil, errors.New("discipline is not offered by the parameterization")
+	}
+	err = checkProfessor(edit, u.ProfessorId, u.DisciplineId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkRoom(edit, u.RoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	section := u.Section
+	if section == 0 {
+		section = 1
+	}
+	class, ok := process.PlaceClass(entity.ClassEntity{
+		UUID:         uuid.New(),
+		Section:      section,
+		DisciplineID: u.DisciplineId,
+		ProfessorID:  u.ProfessorId,
+		ProposalID:   edit.proposal.ID,
+		RoomID:       u.RoomId,
+		Discipline:   discipline,
+	}, *edit.parameterization, process.TimetableWeek(edit.original.Classes, *edit.parameterization), u.DayOfWeek, startTime, endTime)
+	if !ok {
+		return nil, errors.New("invalid day of week")
+	}
+
+	edited := entity.Timetable{Classes: append(edit.original.Classes[:len(edit.original.Classes):len(edit.original.Classes)], class)}
+	return s.applyEdit(ctx, edit, edited, class.UUID, u.Force, userUUID, editReason(u.Reason, "class added"), func(version *entity.ProposalVersionEntity) error {
+		return s.ProposalRepo.CreateProposalClass(ctx, &class, edit.proposal, version)
+	})
+}
+
+func (s *GeneticAlgorithmService) UpdateProposalClass(ctx context.Context, proposalUUID, classUUID, userUUID uuid.UUID, u dto.UpdateProposalClassDto) (*response.ProposalEditResponse, error) {
+	edit, err := s.loadProposalEdit(ctx, proposalUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := findEditableClass(edit, classUUID)
+	if err != nil {
+		return nil, err
+	}
+	class := edit.original.Classes[index]
+
+	// only the fields sent in the body are changed
+	if u.ProfessorId != nil {
+		err = checkProfessor(edit, *u.ProfessorId, class.DisciplineID)
+		if err != nil {
+			return nil, err
+		}
+		class.ProfessorID = *u.ProfessorId
+		class.Professor = nil
+	}
+	if u.RoomId != nil {
+		err = checkRoom(edit, *u.RoomId)
+		if err != nil {
+			return nil, err
+		}
+		class.RoomID = *u.RoomId
+		class.Room = nil
+	}
+
+	dayOfWeek, startTime, endTime := class.DayOfWeek, timeOfDay(class.StartTime), timeOfDay(class.EndTime)
+	if u.DayOfWeek != "" {
+		dayOfWeek = u.DayOfWeek
+	}
+	if u.StartTime != "" {
+		startTime, err = time.Parse(timeOfDayLayout, u.StartTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if u.EndTime != "" {
+		endTime, err = time.Parse(timeOfDayLayout, u.EndTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !endTime.After(startTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+
+	class, ok := process.PlaceClass(class, *edit.parameterization, process.TimetableWeek(edit.original.Classes, *edit.parameterization), dayOfWeek, startTime, endTime)
+	if !ok {
+		return nil, errors.New("invalid day of week")
+	}
+
+	edited := entity.Timetable{Classes: append([]entity.ClassEntity(nil), edit.original.Classes...)}
+	edited.Classes[index] = class
+	return s.applyEdit(ctx, edit, edited, class.UUID, u.Force, userUUID, editReason(u.Reason, "class updated"), func(version *entity.ProposalVersionEntity) error {
+		return s.ProposalRepo.UpdateProposalClass(ctx, &class, edit.proposal, version)
+	})
+}
+
+func (s *GeneticAlgorithmService) DeleteProposalClass(ctx context.Context, proposalUUID, classUUID, userUUID uuid.UUID, force bool, reason string) (*response.ProposalEditResponse, error) {
+	edit, err := s.loadProposalEdit(ctx, proposalUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := findEditableClass(edit, classUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var edited entity.Timetable
+	edited.Classes = append(edited.Classes, edit.original.Classes[:index]...)
+	edited.Classes = append(edited.Classes, edit.original.Classes[index+1:]...)
+	return s.applyEdit(ctx, edit, edited, classUUID, force, userUUID, editReason(reason, "class removed"), func(version *entity.ProposalVersionEntity) error {
+		return s.ProposalRepo.DeleteProposalClass(ctx, classUUID, edit.proposal, version)
+	})
+}
+
+// loadProposalEdit loads the proposal and its classes and scores them with the current
+// configuration of the parameterization that generated it.
+func (s *GeneticAlgorithmService) loadProposalEdit(ctx context.Context, proposalUUID uuid.UUID) (*proposalEdit, error) {
+	proposal, err := s.ProposalRepo.FindProposalByID(ctx, proposalUUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "geneticalgorithmservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+	// once approved a proposal is the version the secretariat works with, so it stays as it is
+	if proposal.Status != entity.ProposalStatusDraft && proposal.Status != entity.ProposalStatusUnderReview {
+		slog.Error("proposal is read-only", slog.String("package", "geneticalgorithmservice"))
+		return nil, errors.New("proposal is read-only")
+	}
+	if proposal.ParameterizationID == 0 {
+		slog.Error("proposal has no parameterization", slog.String("package", "geneticalgorithmservice"))
+		return nil, errors.New("proposal has no parameterization")
+	}
+
+	parameterization, err := s.ParameterizationRepo.FindParameterizationByParameterizationId(ctx, proposal.ParameterizationID)
+	if err != nil {
+		slog.Error("error to search parameterization by id", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+	disciplines, professors, _, err := s.loadParameterization(ctx, parameterization)
+	if err != nil {
+		slog.Error("error to load parameterization", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	classes, err := s.ProposalRepo.FindClassesByProposalID(ctx, proposal.ID)
+	if err != nil {
+		slog.Error("error to find classes by proposal id", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	// scored in the terms the disciplines were generated in, those of the curriculum matrix
+	original := entity.Timetable{Classes: process.WithDisciplineTerms(classes, disciplines)}
+	process.EvaluateFitness(&original, *parameterization)
+
+	return &proposalEdit{
+		proposal:         proposal,
+		parameterization: parameterization,
+		disciplines:      disciplines,
+		professors:       professors,
+		original:         original,
+	}, nil
+}
+
+// applyEdit scores the edited timetable and saves the edit, unless it breaks a hard
+// constraint and is not forced. Either way the response tells the violations and the
+// fitness of the edited timetable; a rejected edit is returned with the error. A saved
+// edit becomes a new version of the proposal, by the user and for the reason given, which
+// save stores together with the edit and the new fitness.
+func (s *GeneticAlgorithmService) applyEdit(ctx context.Context, edit *proposalEdit, edited entity.Timetable, classUUID uuid.UUID, force bool, userUUID uuid.UUID, reason string, save func(version *entity.ProposalVersionEntity) error) (*response.ProposalEditResponse, error) {
+	process.EvaluateFitness(&edited, *edit.parameterization)
+	res := toProposalEditResponse(edit.proposal.UUID, classUUID, edited, process.EvaluateViolations(&edited, *edit.parameterization))
+	res.BrokenConstraints = process.BrokenHardConstraints(edit.original, edited)
+	if len(res.BrokenConstraints) > 0 && !force {
+		slog.Error("edit breaks hard constraints", slog.String("package", "geneticalgorithmservice"))
+		return res, errors.New("edit breaks hard constraints")
+	}
+
+	edit.proposal.Fitness = edited.Fitness
+	edit.proposal.FitnessBreakdown = edited.Breakdown
+	version := entity.ProposalVersionEntity{
+		UUID:       uuid.New(),
+		ProposalID: edit.proposal.ID,
+		UserUUID:   userUUID,
+		Reason:     reason,
+	}
+	err := save(&version)
+	if err != nil {
+		slog.Error("error to save proposal class", "err", err, slog.String("package", "geneticalgorithmservice"))
+		return nil, err
+	}
+
+	res.Applied = true
+	res.Version = version.Version
+	res.Forced = len(res.BrokenConstraints) > 0
+	return res, nil
+}
+
+// findEditableClass finds the class in the proposal. Locked classes are kept as the
+// parameterization places them, so they are changed there and not in a proposal.
+func findEditableClass(edit *proposalEdit, classUUID uuid.UUID) (int, error) {
+	for i, class := range edit.original.Classes {
+		if class.UUID == classUUID {
+			if class.Locked {
+				slog.Error("class is locked", slog.String("package", "geneticalgorithmservice"))
+				return 0, errors.New("class is locked")
+			}
+			return i, nil
+		}
+	}
+	slog.Error("class not found in proposal", slog.String("package", "geneticalgorithmservice"))
+	return 0, errors.New("class not found in proposal")
+}
+
+func checkProfessor(edit *proposalEdit, professorID, disciplineID int64) error {
+	for _, professor := range process.FilterEligibleProfessors(disciplineID, edit.professors) {
+		if professor.ID == professorID {
+			return nil
+		}
+	}
+	slog.Error("professor is not eligible for the discipline", slog.String("package", "geneticalgorithmservice"))
+	return errors.New("professor is not eligible for the discipline")
+}
+
+// checkRoom accepts no room or one of the rooms at the course location.
+func checkRoom(edit *proposalEdit, roomID int64) error {
+	if roomID == 0 {
+		return nil
+	}
+	for _, room := range edit.parameterization.Rooms {
+		if room.ID == roomID {
+			return nil
+		}
+	}
+	slog.Error("room not found", slog.String("package", "geneticalgorithmservice"))
+	return errors.New("room not found")
+}
+
+// editReason is the reason the user gave for an edit, or the kind of edit when none was given.
+func editReason(reason, kind string) string {
+	if reason == "" {
+		return kind
+	}
+	return reason
+}
+
+// timeOfDay keeps only the time of day of a class, as edits write it.
+func timeOfDay(t time.Time) time.Time {
+	return time.Date(0, 1, 1, t.Hour(), t.Minute(), 0, 0, time.UTC)
+}
+
+func toProposalEditResponse(proposalUUID, classUUID uuid.UUID, timetable entity.Timetable, violations []process.ConstraintViolation) *response.ProposalEditResponse {
+	res := response.ProposalEditResponse{
+		ProposalUUID: proposalUUID.String(),
+		ClassUUID:    classUUID.String(),
+		Fitness:      timetable.Fitness,
+	}
+	for _, score := range timetable.Breakdown {
+		res.FitnessBreakdown = append(res.FitnessBreakdown, dto.ConstraintScoreDTO{
+			Name:       score.Name,
+			Hard:       score.Hard,
+			Weight:     score.Weight,
+			Violations: score.Violations,
+			Penalty:    score.Penalty,
+		})
+	}
+	for _, violation := range violations {
+		violationResponse := response.ProposalViolationResponse{
+			Constraint: violation.Constraint,
+			Hard:       violation.Hard,
+			Count:      violation.Count,
+		}
+		for _, i := range violation.Classes {
+			violationResponse.ClassUuids = append(violationResponse.ClassUuids, timetable.Classes[i].UUID.String())
+		}
+		res.Violations = append(res.Violations, violationResponse)
+	}
+	return &res
+}
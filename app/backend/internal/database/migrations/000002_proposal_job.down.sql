@@ -0,0 +1,6 @@
+drop index if exists idx_proposal_job_parameterization_id;
+drop index if exists idx_proposal_job_status;
+
+drop table if exists proposal_job;
+
+drop sequence if exists proposal_job_id_seq;
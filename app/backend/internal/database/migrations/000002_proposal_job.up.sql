@@ -0,0 +1,19 @@
+CREATE SEQUENCE if not exists proposal_job_id_seq START 1;
+
+CREATE TABLE if not exists proposal_job (
+    id BIGINT PRIMARY KEY DEFAULT nextval('proposal_job_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    status VARCHAR(20) NOT NULL,
+    progress INT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    parameterization_id BIGINT NOT NULL,
+    proposal_id BIGINT,
+    constraint proposal_job_parameterization_id_fk foreign key(parameterization_id) references parameterization(id) ON DELETE CASCADE,
+    constraint proposal_job_proposal_id_fk foreign key(proposal_id) references proposal(id)
+    );
+
+CREATE INDEX idx_proposal_job_parameterization_id ON proposal_job(parameterization_id);
+CREATE INDEX idx_proposal_job_status ON proposal_job(status);
@@ -0,0 +1,11 @@
+ALTER TABLE parameterization
+    DROP CONSTRAINT if exists parameterization_population_size_check,
+    DROP CONSTRAINT if exists parameterization_generations_check,
+    DROP CONSTRAINT if exists parameterization_tournament_size_check,
+    DROP CONSTRAINT if exists parameterization_mutation_rate_check;
+
+ALTER TABLE parameterization
+    DROP COLUMN if exists populationSize,
+    DROP COLUMN if exists generations,
+    DROP COLUMN if exists tournamentSize,
+    DROP COLUMN if exists mutationRate;
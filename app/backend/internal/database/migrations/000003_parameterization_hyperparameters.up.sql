@@ -0,0 +1,11 @@
+ALTER TABLE parameterization
+    ADD COLUMN populationSize INT NOT NULL DEFAULT 100,
+    ADD COLUMN generations INT NOT NULL DEFAULT 1000,
+    ADD COLUMN tournamentSize INT NOT NULL DEFAULT 5,
+    ADD COLUMN mutationRate DOUBLE PRECISION NOT NULL DEFAULT 0.01;
+
+ALTER TABLE parameterization
+    ADD CONSTRAINT parameterization_population_size_check CHECK (populationSize >= 2),
+    ADD CONSTRAINT parameterization_generations_check CHECK (generations >= 1),
+    ADD CONSTRAINT parameterization_tournament_size_check CHECK (tournamentSize >= 1 AND tournamentSize <= populationSize),
+    ADD CONSTRAINT parameterization_mutation_rate_check CHECK (mutationRate >= 0 AND mutationRate <= 1);
@@ -0,0 +1,3 @@
+ALTER TABLE proposal_job DROP COLUMN if exists seed;
+
+ALTER TABLE proposal DROP COLUMN if exists seed;
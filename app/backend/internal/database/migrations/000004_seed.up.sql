@@ -0,0 +1,3 @@
+ALTER TABLE proposal ADD COLUMN seed BIGINT;
+
+ALTER TABLE proposal_job ADD COLUMN seed BIGINT;
@@ -0,0 +1,11 @@
+drop index if exists idx_class_proposal_id;
+
+ALTER TABLE proposal
+    DROP CONSTRAINT if exists proposal_parameterization_id_fk,
+    DROP COLUMN if exists fitness,
+    DROP COLUMN if exists parameterization_id,
+    DROP COLUMN if exists population_size,
+    DROP COLUMN if exists generations,
+    DROP COLUMN if exists tournament_size,
+    DROP COLUMN if exists mutation_rate,
+    DROP COLUMN if exists created_at;
@@ -0,0 +1,11 @@
+ALTER TABLE proposal
+    ADD COLUMN fitness DOUBLE PRECISION,
+    ADD COLUMN parameterization_id BIGINT,
+    ADD COLUMN population_size INT,
+    ADD COLUMN generations INT,
+    ADD COLUMN tournament_size INT,
+    ADD COLUMN mutation_rate DOUBLE PRECISION,
+    ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT now(),
+    ADD CONSTRAINT proposal_parameterization_id_fk FOREIGN KEY (parameterization_id) REFERENCES parameterization(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_class_proposal_id ON class(proposal_id);
@@ -0,0 +1,3 @@
+ALTER TABLE class DROP CONSTRAINT if exists class_section_check;
+
+ALTER TABLE class DROP COLUMN if exists section;
@@ -0,0 +1,3 @@
+ALTER TABLE class ADD COLUMN section INT NOT NULL DEFAULT 1;
+
+ALTER TABLE class ADD CONSTRAINT class_section_check CHECK (section >= 1);
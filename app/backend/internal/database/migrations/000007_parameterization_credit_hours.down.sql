@@ -0,0 +1,7 @@
+ALTER TABLE parameterization
+    DROP CONSTRAINT if exists parameterization_hours_per_credit_check,
+    DROP CONSTRAINT if exists parameterization_max_block_hours_check;
+
+ALTER TABLE parameterization
+    DROP COLUMN if exists hoursPerCredit,
+    DROP COLUMN if exists maxBlockHours;
@@ -0,0 +1,7 @@
+ALTER TABLE parameterization
+    ADD COLUMN hoursPerCredit INT NOT NULL DEFAULT 1,
+    ADD COLUMN maxBlockHours INT NOT NULL DEFAULT 2;
+
+ALTER TABLE parameterization
+    ADD CONSTRAINT parameterization_hours_per_credit_check CHECK (hoursPerCredit >= 1),
+    ADD CONSTRAINT parameterization_max_block_hours_check CHECK (maxBlockHours >= 1);
@@ -0,0 +1,3 @@
+drop table if exists parameterization_disciplines;
+
+drop sequence if exists parameterization_disciplines_id_seq;
@@ -0,0 +1,13 @@
+CREATE SEQUENCE if not exists parameterization_disciplines_id_seq START 1;
+
+CREATE TABLE if not exists parameterization_disciplines (
+    id BIGINT PRIMARY KEY DEFAULT nextval('parameterization_disciplines_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    parameterization_id BIGINT NOT NULL,
+    discipline_id BIGINT NOT NULL,
+    priority INT NOT NULL DEFAULT 0,
+    mandatory BOOLEAN NOT NULL DEFAULT false,
+    constraint parameterization_disciplines_parameterization_id_fk foreign key(parameterization_id) references parameterization(id) ON DELETE CASCADE,
+    constraint parameterization_disciplines_discipline_id_fk foreign key(discipline_id) references discipline(id) ON DELETE CASCADE,
+    constraint parameterization_disciplines_unique UNIQUE (parameterization_id, discipline_id)
+);
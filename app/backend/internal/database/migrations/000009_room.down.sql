@@ -0,0 +1,15 @@
+ALTER TABLE class DROP CONSTRAINT if exists class_room_id_fk;
+
+ALTER TABLE class DROP COLUMN if exists room_id;
+
+ALTER TABLE discipline
+    DROP CONSTRAINT if exists discipline_room_type_check,
+    DROP CONSTRAINT if exists discipline_expected_enrolment_check;
+
+ALTER TABLE discipline
+    DROP COLUMN if exists room_type,
+    DROP COLUMN if exists expected_enrolment;
+
+drop table if exists room;
+
+drop sequence if exists room_id_seq;
@@ -0,0 +1,24 @@
+CREATE SEQUENCE if not exists room_id_seq START 1;
+
+CREATE TABLE if not exists room (
+    id BIGINT PRIMARY KEY DEFAULT nextval('room_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    capacity INT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    location VARCHAR(255) NOT NULL,
+    constraint room_capacity_check CHECK (capacity >= 1),
+    constraint room_type_check CHECK (type IN ('lecture', 'lab'))
+);
+
+ALTER TABLE discipline
+    ADD COLUMN room_type VARCHAR(50) NOT NULL DEFAULT 'lecture',
+    ADD COLUMN expected_enrolment INT NOT NULL DEFAULT 0;
+
+ALTER TABLE discipline
+    ADD CONSTRAINT discipline_room_type_check CHECK (room_type IN ('lecture', 'lab')),
+    ADD CONSTRAINT discipline_expected_enrolment_check CHECK (expected_enrolment >= 0);
+
+ALTER TABLE class ADD COLUMN room_id BIGINT;
+
+ALTER TABLE class ADD CONSTRAINT class_room_id_fk foreign key(room_id) references room(id) ON DELETE SET NULL;
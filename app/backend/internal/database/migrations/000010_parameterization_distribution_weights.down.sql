@@ -0,0 +1,9 @@
+ALTER TABLE parameterization
+    DROP CONSTRAINT if exists parameterization_spread_weight_check,
+    DROP CONSTRAINT if exists parameterization_same_day_weight_check,
+    DROP CONSTRAINT if exists parameterization_idle_gap_weight_check;
+
+ALTER TABLE parameterization
+    DROP COLUMN if exists spreadWeight,
+    DROP COLUMN if exists sameDayWeight,
+    DROP COLUMN if exists idleGapWeight;
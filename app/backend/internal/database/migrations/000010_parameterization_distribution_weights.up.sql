@@ -0,0 +1,9 @@
+ALTER TABLE parameterization
+    ADD COLUMN spreadWeight DOUBLE PRECISION NOT NULL DEFAULT 1,
+    ADD COLUMN sameDayWeight DOUBLE PRECISION NOT NULL DEFAULT 1,
+    ADD COLUMN idleGapWeight DOUBLE PRECISION NOT NULL DEFAULT 1;
+
+ALTER TABLE parameterization
+    ADD CONSTRAINT parameterization_spread_weight_check CHECK (spreadWeight >= 0),
+    ADD CONSTRAINT parameterization_same_day_weight_check CHECK (sameDayWeight >= 0),
+    ADD CONSTRAINT parameterization_idle_gap_weight_check CHECK (idleGapWeight >= 0);
@@ -0,0 +1,2 @@
+ALTER TABLE proposal
+    DROP COLUMN if exists fitness_breakdown;
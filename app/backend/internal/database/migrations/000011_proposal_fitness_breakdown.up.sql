@@ -0,0 +1,2 @@
+ALTER TABLE proposal
+    ADD COLUMN fitness_breakdown JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,3 @@
+drop table if exists parameterization_constraints;
+
+drop sequence if exists parameterization_constraints_id_seq;
@@ -0,0 +1,13 @@
+CREATE SEQUENCE if not exists parameterization_constraints_id_seq START 1;
+
+CREATE TABLE if not exists parameterization_constraints (
+    id BIGINT PRIMARY KEY DEFAULT nextval('parameterization_constraints_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    parameterization_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    weight DOUBLE PRECISION NOT NULL DEFAULT 0,
+    constraint parameterization_constraints_parameterization_id_fk foreign key(parameterization_id) references parameterization(id) ON DELETE CASCADE,
+    constraint parameterization_constraints_unique UNIQUE (parameterization_id, name),
+    constraint parameterization_constraints_weight_check CHECK (weight >= 0)
+);
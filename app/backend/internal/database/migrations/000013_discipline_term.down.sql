@@ -0,0 +1,5 @@
+ALTER TABLE discipline
+    DROP CONSTRAINT if exists discipline_term_check;
+
+ALTER TABLE discipline
+    DROP COLUMN if exists term;
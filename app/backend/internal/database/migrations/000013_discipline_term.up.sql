@@ -0,0 +1,5 @@
+ALTER TABLE discipline
+    ADD COLUMN term INT NOT NULL DEFAULT 0;
+
+ALTER TABLE discipline
+    ADD CONSTRAINT discipline_term_check CHECK (term >= 0);
@@ -0,0 +1,5 @@
+ALTER TABLE discipline
+    DROP CONSTRAINT if exists discipline_code_unique;
+
+ALTER TABLE discipline
+    DROP COLUMN if exists code;
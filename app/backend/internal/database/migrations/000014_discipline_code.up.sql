@@ -0,0 +1,10 @@
+ALTER TABLE discipline
+    ADD COLUMN code VARCHAR(20);
+
+UPDATE discipline SET code = 'DISC' || id WHERE code IS NULL;
+
+ALTER TABLE discipline
+    ALTER COLUMN code SET NOT NULL;
+
+ALTER TABLE discipline
+    ADD CONSTRAINT discipline_code_unique UNIQUE (code);
@@ -0,0 +1,7 @@
+drop table if exists curriculum_matrix_disciplines;
+
+drop table if exists curriculum_matrix;
+
+drop sequence if exists curriculum_matrix_disciplines_id_seq;
+
+drop sequence if exists curriculum_matrix_id_seq;
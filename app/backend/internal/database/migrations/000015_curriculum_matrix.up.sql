@@ -0,0 +1,28 @@
+CREATE SEQUENCE if not exists curriculum_matrix_id_seq START 1;
+CREATE SEQUENCE if not exists curriculum_matrix_disciplines_id_seq START 1;
+
+CREATE TABLE if not exists curriculum_matrix (
+    id BIGINT PRIMARY KEY DEFAULT nextval('curriculum_matrix_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    course_id BIGINT NOT NULL,
+    version VARCHAR(20) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT false,
+    constraint curriculum_matrix_course_id_fk foreign key(course_id) references course(id) ON DELETE CASCADE,
+    constraint curriculum_matrix_unique UNIQUE (course_id, version)
+);
+
+-- a course has at most one active matrix version
+CREATE UNIQUE INDEX if not exists curriculum_matrix_active_idx ON curriculum_matrix (course_id) WHERE active;
+
+CREATE TABLE if not exists curriculum_matrix_disciplines (
+    id BIGINT PRIMARY KEY DEFAULT nextval('curriculum_matrix_disciplines_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    curriculum_matrix_id BIGINT NOT NULL,
+    discipline_id BIGINT NOT NULL,
+    term INT NOT NULL,
+    mandatory BOOLEAN NOT NULL DEFAULT true,
+    constraint curriculum_matrix_disciplines_curriculum_matrix_id_fk foreign key(curriculum_matrix_id) references curriculum_matrix(id) ON DELETE CASCADE,
+    constraint curriculum_matrix_disciplines_discipline_id_fk foreign key(discipline_id) references discipline(id) ON DELETE CASCADE,
+    constraint curriculum_matrix_disciplines_unique UNIQUE (curriculum_matrix_id, discipline_id),
+    constraint curriculum_matrix_disciplines_term_check CHECK (term >= 1)
+);
@@ -0,0 +1,18 @@
+drop table if exists shift_hours;
+
+drop sequence if exists shift_hours_id_seq;
+
+ALTER TABLE availability
+    ADD COLUMN shift VARCHAR(50);
+
+UPDATE availability SET shift = CASE
+    WHEN start_time < '13:00' THEN 'Morning'
+    WHEN start_time < '19:00' THEN 'Afternoon'
+    ELSE 'Night'
+END;
+
+ALTER TABLE availability
+    ALTER COLUMN shift SET NOT NULL,
+    DROP CONSTRAINT if exists availability_time_check,
+    DROP COLUMN if exists start_time,
+    DROP COLUMN if exists end_time;
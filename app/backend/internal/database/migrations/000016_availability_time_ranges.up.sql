@@ -0,0 +1,37 @@
+ALTER TABLE availability
+    ADD COLUMN start_time TIME,
+    ADD COLUMN end_time TIME;
+
+-- the hours each shift had while they were fixed in the generation
+UPDATE availability SET start_time = '08:00', end_time = '12:00' WHERE shift = 'Morning';
+UPDATE availability SET start_time = '13:00', end_time = '18:00' WHERE shift = 'Afternoon';
+UPDATE availability SET start_time = '19:00', end_time = '23:00' WHERE shift = 'Night';
+
+-- the generation stopped on any other shift, so those rows never were usable availability
+DELETE FROM availability WHERE start_time IS NULL;
+
+ALTER TABLE availability
+    ALTER COLUMN start_time SET NOT NULL,
+    ALTER COLUMN end_time SET NOT NULL,
+    DROP COLUMN shift;
+
+ALTER TABLE availability
+    ADD CONSTRAINT availability_time_check CHECK (start_time < end_time);
+
+CREATE SEQUENCE if not exists shift_hours_id_seq START 1;
+
+CREATE TABLE if not exists shift_hours (
+    id BIGINT PRIMARY KEY DEFAULT nextval('shift_hours_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    shift VARCHAR(50) NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    course_id BIGINT,
+    location VARCHAR(255),
+    constraint shift_hours_course_id_fk foreign key(course_id) references course(id) ON DELETE CASCADE,
+    constraint shift_hours_time_check CHECK (start_time < end_time),
+    constraint shift_hours_scope_check CHECK (course_id IS NULL OR location IS NULL)
+);
+
+-- a shift has one set of hours per course, per location and institution-wide
+CREATE UNIQUE INDEX if not exists shift_hours_unique_idx ON shift_hours (shift, COALESCE(course_id, 0), COALESCE(location, ''));
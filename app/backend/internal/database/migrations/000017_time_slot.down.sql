@@ -0,0 +1,6 @@
+drop table if exists time_slot;
+
+drop sequence if exists time_slot_id_seq;
+
+ALTER TABLE shift_hours
+    DROP CONSTRAINT if exists shift_hours_shift_check;
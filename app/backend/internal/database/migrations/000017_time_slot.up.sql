@@ -0,0 +1,22 @@
+-- shifts other than these were never scheduled, as the generation stopped on them
+DELETE FROM shift_hours WHERE shift NOT IN ('Morning', 'Afternoon', 'Night');
+
+ALTER TABLE shift_hours
+    ADD CONSTRAINT shift_hours_shift_check CHECK (shift IN ('Morning', 'Afternoon', 'Night'));
+
+CREATE SEQUENCE if not exists time_slot_id_seq START 1;
+
+-- the official class periods; a slot without weekdays applies from Monday to Friday
+CREATE TABLE if not exists time_slot (
+    id BIGINT PRIMARY KEY DEFAULT nextval('time_slot_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    label VARCHAR(50) NOT NULL,
+    shift VARCHAR(50) NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    weekdays TEXT[] NOT NULL DEFAULT '{}',
+    constraint time_slot_label_unique UNIQUE (label),
+    constraint time_slot_time_check CHECK (start_time < end_time),
+    constraint time_slot_shift_check CHECK (shift IN ('Morning', 'Afternoon', 'Night')),
+    constraint time_slot_weekdays_check CHECK (weekdays <@ ARRAY['Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday']::TEXT[])
+);
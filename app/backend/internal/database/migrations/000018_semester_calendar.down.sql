@@ -0,0 +1,8 @@
+drop table if exists semester_holiday;
+
+drop sequence if exists semester_holiday_id_seq;
+
+ALTER TABLE semester
+    DROP CONSTRAINT if exists semester_dates_check,
+    DROP COLUMN if exists start_date,
+    DROP COLUMN if exists end_date;
@@ -0,0 +1,17 @@
+ALTER TABLE semester
+    ADD COLUMN start_date DATE,
+    ADD COLUMN end_date DATE,
+    ADD CONSTRAINT semester_dates_check CHECK (start_date <= end_date);
+
+CREATE SEQUENCE if not exists semester_holiday_id_seq START 1;
+
+-- days of the semester without classes
+CREATE TABLE if not exists semester_holiday (
+    id BIGINT PRIMARY KEY DEFAULT nextval('semester_holiday_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    semester_id BIGINT NOT NULL,
+    date DATE NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    constraint semester_holiday_semester_id_fk foreign key(semester_id) references semester(id) ON DELETE CASCADE,
+    constraint semester_holiday_unique UNIQUE (semester_id, date)
+);
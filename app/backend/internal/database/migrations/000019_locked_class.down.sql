@@ -0,0 +1,5 @@
+drop table if exists locked_class;
+
+drop sequence if exists locked_class_id_seq;
+
+ALTER TABLE class DROP COLUMN if exists locked;
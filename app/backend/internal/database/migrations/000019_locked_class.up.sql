@@ -0,0 +1,25 @@
+ALTER TABLE class ADD COLUMN locked BOOLEAN NOT NULL DEFAULT false;
+
+CREATE SEQUENCE if not exists locked_class_id_seq START 1;
+
+-- placements agreed before the generation; every proposal of the parameterization keeps them
+CREATE TABLE if not exists locked_class (
+    id BIGINT PRIMARY KEY DEFAULT nextval('locked_class_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    parameterization_id BIGINT NOT NULL,
+    discipline_id BIGINT NOT NULL,
+    professor_id BIGINT NOT NULL,
+    room_id BIGINT,
+    section INT NOT NULL DEFAULT 1,
+    day_of_week VARCHAR(20) NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    constraint locked_class_parameterization_id_fk foreign key(parameterization_id) references parameterization(id) ON DELETE CASCADE,
+    constraint locked_class_discipline_id_fk foreign key(discipline_id) references discipline(id) ON DELETE CASCADE,
+    constraint locked_class_professor_id_fk foreign key(professor_id) references professor(id) ON DELETE CASCADE,
+    constraint locked_class_room_id_fk foreign key(room_id) references room(id) ON DELETE SET NULL,
+    constraint locked_class_time_check CHECK (start_time < end_time),
+    constraint locked_class_day_of_week_check CHECK (day_of_week IN ('Monday', 'Tuesday', 'Wednesday', 'Thursday', 'Friday', 'Saturday'))
+);
+
+CREATE INDEX if not exists idx_locked_class_parameterization_id ON locked_class(parameterization_id);
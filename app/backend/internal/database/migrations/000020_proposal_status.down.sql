@@ -0,0 +1,7 @@
+drop table if exists proposal_transition;
+
+drop sequence if exists proposal_transition_id_seq;
+
+drop index if exists proposal_approved_unique_idx;
+
+ALTER TABLE proposal DROP COLUMN if exists status;
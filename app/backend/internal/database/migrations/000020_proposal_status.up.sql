@@ -0,0 +1,24 @@
+ALTER TABLE proposal ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft';
+
+ALTER TABLE proposal
+    ADD CONSTRAINT proposal_status_check CHECK (status IN ('draft', 'under_review', 'approved', 'published', 'archived'));
+
+-- a published proposal is still the approved one, so a course has a single one of either per semester
+CREATE UNIQUE INDEX if not exists proposal_approved_unique_idx ON proposal (course_id, semester_id) WHERE status IN ('approved', 'published');
+
+CREATE SEQUENCE if not exists proposal_transition_id_seq START 1;
+
+CREATE TABLE if not exists proposal_transition (
+    id BIGINT PRIMARY KEY DEFAULT nextval('proposal_transition_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    proposal_id BIGINT NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    user_id BIGINT,
+    comment TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    constraint proposal_transition_proposal_id_fk foreign key(proposal_id) references proposal(id) ON DELETE CASCADE,
+    constraint proposal_transition_user_id_fk foreign key(user_id) references users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX if not exists idx_proposal_transition_proposal_id ON proposal_transition(proposal_id);
@@ -0,0 +1,7 @@
+DELETE FROM class WHERE version_id IS NOT NULL;
+
+ALTER TABLE class DROP COLUMN if exists version_id;
+
+drop table if exists proposal_version;
+
+drop sequence if exists proposal_version_id_seq;
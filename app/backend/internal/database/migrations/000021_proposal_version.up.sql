@@ -0,0 +1,35 @@
+CREATE SEQUENCE if not exists proposal_version_id_seq START 1;
+
+-- snapshots of the classes of a proposal; the classes of a version are rows of class pointing to it
+-- and are never changed, while the classes without a version are the current ones
+CREATE TABLE if not exists proposal_version (
+    id BIGINT PRIMARY KEY DEFAULT nextval('proposal_version_id_seq'),
+    uuid UUID NOT NULL DEFAULT gen_random_uuid(),
+    proposal_id BIGINT NOT NULL,
+    version INT NOT NULL,
+    user_id BIGINT,
+    reason TEXT,
+    fitness DOUBLE PRECISION,
+    fitness_breakdown JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    constraint proposal_version_proposal_id_fk foreign key(proposal_id) references proposal(id) ON DELETE CASCADE,
+    constraint proposal_version_user_id_fk foreign key(user_id) references users(id) ON DELETE SET NULL,
+    constraint proposal_version_unique UNIQUE (proposal_id, version)
+);
+
+ALTER TABLE class ADD COLUMN version_id BIGINT;
+
+ALTER TABLE class ADD CONSTRAINT class_version_id_fk foreign key(version_id) references proposal_version(id) ON DELETE CASCADE;
+
+CREATE INDEX if not exists idx_class_version_id ON class(version_id);
+
+-- the proposals saved so far start their history with their current classes
+INSERT INTO proposal_version (proposal_id, version, reason, fitness, fitness_breakdown, created_at)
+SELECT p.id, 1, 'generated', p.fitness, p.fitness_breakdown, p.created_at
+FROM proposal p;
+
+INSERT INTO class (uuid, dayOfWeek, shift, startTime, endTime, discipline_id, professor_id, proposal_id, section, room_id, locked, version_id)
+SELECT c.uuid, c.dayOfWeek, c.shift, c.startTime, c.endTime, c.discipline_id, c.professor_id, c.proposal_id, c.section, c.room_id, c.locked, v.id
+FROM class c
+         JOIN proposal_version v ON v.proposal_id = c.proposal_id
+WHERE c.version_id IS NULL;
@@ -2,32 +2,33 @@
 SELECT * from availability a where a.uuid = $1;
 
 -- name: CreateAvailability :exec
-INSERT INTO availability (uuid, dayOfWeek, shift, professor_id)
-VALUES ($1, $2, $3, $4);
+INSERT INTO availability (uuid, dayOfWeek, start_time, end_time, professor_id)
+VALUES ($1, $2, $3, $4, $5);
 
 -- name: FindAvailabilityByID :one
-SELECT a.id, a.uuid, a.dayOfWeek, a.shift, a.professor_id
+SELECT a.id, a.uuid, a.dayOfWeek, a.professor_id, a.start_time, a.end_time
 FROM availability a
 WHERE a.uuid = $1;
 
 -- name: UpdateAvailability :exec
 UPDATE availability SET
     dayOfWeek = COALESCE(sqlc.narg('dayOfWeek'), dayOfWeek),
-    shift = COALESCE(sqlc.narg('shift'), shift)
+    start_time = COALESCE(sqlc.narg('start_time'), start_time),
+    end_time = COALESCE(sqlc.narg('end_time'), end_time)
 WHERE uuid = $1;
 
 -- name: DeleteAvailability :exec
 DELETE FROM availability WHERE uuid = $1;
 
 -- name: FindManyAvailabilities :many
-SELECT a.id, a.uuid, a.dayOfWeek, a.shift, a.professor_id
+SELECT a.id, a.uuid, a.dayOfWeek, a.professor_id, a.start_time, a.end_time
 FROM availability a
-ORDER BY a.dayOfWeek, a.shift ASC;
+ORDER BY a.dayOfWeek, a.start_time ASC;
 
 -- name: FindManyAvailabilitiesByProfessorId :many
-SELECT a.id, a.uuid, a.dayOfWeek, a.shift, a.professor_id
+SELECT a.id, a.uuid, a.dayOfWeek, a.professor_id, a.start_time, a.end_time
 FROM availability a
 WHERE a.professor_id = $1
-ORDER BY a.professor_id, a.dayOfWeek, a.shift ASC;
+ORDER BY a.professor_id, a.dayOfWeek, a.start_time ASC;
 
 
@@ -0,0 +1,90 @@
+-- name: CreateCurriculumMatrix :exec
+INSERT INTO curriculum_matrix (uuid, course_id, version, active)
+VALUES ($1, $2, $3, $4);
+
+-- name: FindCurriculumMatrixByID :one
+SELECT cm.id, cm.uuid, cm.course_id, cm.version, cm.active
+FROM curriculum_matrix cm
+WHERE cm.uuid = $1;
+
+-- name: FindCurriculumMatrixByVersion :one
+SELECT cm.id, cm.uuid, cm.course_id, cm.version, cm.active
+FROM curriculum_matrix cm
+WHERE cm.course_id = $1 AND cm.version = $2;
+
+-- name: UpdateCurriculumMatrix :exec
+UPDATE curriculum_matrix SET
+    version = $2,
+    active = $3
+WHERE uuid = $1;
+
+-- name: DeactivateCurriculumMatricesByCourseId :exec
+UPDATE curriculum_matrix SET active = false WHERE course_id = $1 AND active;
+
+-- name: DeleteCurriculumMatrix :exec
+DELETE FROM curriculum_matrix WHERE uuid = $1;
+
+-- name: FindManyCurriculumMatricesByCourseId :many
+SELECT cm.id, cm.uuid, cm.course_id, cm.version, cm.active
+FROM curriculum_matrix cm
+WHERE cm.course_id = $1
+ORDER BY cm.version ASC;
+
+-- name: CreateCurriculumMatrixDiscipline :exec
+INSERT INTO curriculum_matrix_disciplines (uuid, curriculum_matrix_id, discipline_id, term, mandatory)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: FindCurriculumMatrixDisciplineByID :one
+SELECT cmd.id, cmd.uuid, cmd.curriculum_matrix_id, cmd.discipline_id, cmd.term, cmd.mandatory
+FROM curriculum_matrix_disciplines cmd
+WHERE cmd.uuid = $1;
+
+-- name: FindCurriculumMatrixDisciplineByDisciplineId :one
+SELECT cmd.id, cmd.uuid, cmd.curriculum_matrix_id, cmd.discipline_id, cmd.term, cmd.mandatory
+FROM curriculum_matrix_disciplines cmd
+WHERE cmd.curriculum_matrix_id = $1 AND cmd.discipline_id = $2;
+
+-- name: IsDisciplineOfCurriculumMatrixCourse :one
+SELECT EXISTS (SELECT 1
+               FROM curriculum_matrix cm
+                        JOIN discipline d ON d.course_id = cm.course_id
+               WHERE cm.id = sqlc.arg('curriculum_matrix_id') AND d.id = sqlc.arg('discipline_id'));
+
+-- name: FindDisciplineOfCurriculumMatrixCourseByCode :one
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
+FROM curriculum_matrix cm
+         JOIN discipline d ON d.course_id = cm.course_id
+WHERE cm.id = $1 AND d.code = $2;
+
+-- name: UpdateCurriculumMatrixDiscipline :exec
+UPDATE curriculum_matrix_disciplines SET
+    term = $2,
+    mandatory = $3
+WHERE uuid = $1;
+
+-- name: DeleteCurriculumMatrixDiscipline :exec
+DELETE FROM curriculum_matrix_disciplines WHERE uuid = $1;
+
+-- name: DeleteCurriculumMatrixDisciplinesByCurriculumMatrixId :exec
+DELETE FROM curriculum_matrix_disciplines WHERE curriculum_matrix_id = $1;
+
+-- name: FindManyCurriculumMatrixDisciplinesByCurriculumMatrixId :many
+SELECT cmd.id, cmd.uuid, cmd.curriculum_matrix_id, cmd.discipline_id, cmd.term, cmd.mandatory,
+       d.uuid AS discipline_uuid, d.code AS discipline_code, d.name AS discipline_name,
+       d.credits AS discipline_credits, d.course_id AS discipline_course_id,
+       d.room_type AS discipline_room_type, d.expected_enrolment AS discipline_expected_enrolment
+FROM curriculum_matrix_disciplines cmd
+         JOIN discipline d ON d.id = cmd.discipline_id
+WHERE cmd.curriculum_matrix_id = $1
+ORDER BY cmd.term, d.code ASC;
+
+-- name: FindActiveCurriculumMatrixDisciplinesByCourseId :many
+SELECT cmd.id, cmd.uuid, cmd.curriculum_matrix_id, cmd.discipline_id, cmd.term, cmd.mandatory,
+       d.uuid AS discipline_uuid, d.code AS discipline_code, d.name AS discipline_name,
+       d.credits AS discipline_credits, d.course_id AS discipline_course_id,
+       d.room_type AS discipline_room_type, d.expected_enrolment AS discipline_expected_enrolment
+FROM curriculum_matrix cm
+         JOIN curriculum_matrix_disciplines cmd ON cmd.curriculum_matrix_id = cm.id
+         JOIN discipline d ON d.id = cmd.discipline_id
+WHERE cm.course_id = $1 AND cm.active
+ORDER BY cmd.term, d.code ASC;
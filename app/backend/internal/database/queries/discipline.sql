@@ -2,30 +2,39 @@
 SELECT * from discipline d where d.uuid = $1;
 
 -- name: CreateDiscipline :exec
-INSERT INTO discipline (uuid, name, credits, course_id)
-VALUES ($1, $2, $3, $4);
+INSERT INTO discipline (uuid, name, credits, course_id, room_type, expected_enrolment, term, code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: FindDisciplineByID :one
-SELECT d.id, d.uuid, d.name, d.credits, d.course_id
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
 FROM discipline d
 WHERE d.uuid = $1;
 
+-- name: FindDisciplineByCode :one
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
+FROM discipline d
+WHERE d.code = $1;
+
 -- name: UpdateDiscipline :exec
 UPDATE discipline SET
     name = COALESCE(sqlc.narg('name'), name),
-    credits = COALESCE(sqlc.narg('credits'), credits)
+    credits = COALESCE(sqlc.narg('credits'), credits),
+    room_type = COALESCE(sqlc.narg('room_type'), room_type),
+    expected_enrolment = COALESCE(sqlc.narg('expected_enrolment'), expected_enrolment),
+    term = COALESCE(sqlc.narg('term'), term),
+    code = COALESCE(sqlc.narg('code'), code)
 WHERE uuid = $1;
 
 -- name: DeleteDiscipline :exec
 DELETE FROM discipline WHERE uuid = $1;
 
 -- name: FindManyDisciplines :many
-SELECT d.id, d.uuid, d.name, d.credits, d.course_id
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
 FROM discipline d
 ORDER BY d.course_id, d.name ASC;
 
 -- name: FindManyDisciplinesByCourseId :many
-SELECT d.id, d.uuid, d.name, d.credits, d.course_id
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
 FROM discipline d
 WHERE d.course_id = $1
 ORDER BY d.name ASC;
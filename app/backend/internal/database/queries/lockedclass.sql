@@ -0,0 +1,29 @@
+-- name: CreateLockedClass :exec
+INSERT INTO locked_class (uuid, parameterization_id, discipline_id, professor_id, room_id, section, day_of_week, start_time, end_time)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: FindLockedClassByID :one
+SELECT lc.id, lc.uuid, lc.parameterization_id, lc.discipline_id, lc.professor_id, lc.room_id, lc.section,
+       lc.day_of_week, lc.start_time, lc.end_time
+FROM locked_class lc
+WHERE lc.uuid = $1;
+
+-- name: UpdateLockedClass :exec
+UPDATE locked_class SET
+    professor_id = $2,
+    room_id = $3,
+    section = $4,
+    day_of_week = $5,
+    start_time = $6,
+    end_time = $7
+WHERE uuid = $1;
+
+-- name: DeleteLockedClass :exec
+DELETE FROM locked_class WHERE uuid = $1;
+
+-- name: FindManyLockedClassesByParameterizationId :many
+SELECT lc.id, lc.uuid, lc.parameterization_id, lc.discipline_id, lc.professor_id, lc.room_id, lc.section,
+       lc.day_of_week, lc.start_time, lc.end_time
+FROM locked_class lc
+WHERE lc.parameterization_id = $1
+ORDER BY lc.discipline_id, lc.section, lc.day_of_week, lc.start_time;
@@ -2,30 +2,44 @@
 SELECT * from parameterization p where p.uuid = $1;
 
 -- name: CreateParameterization :exec
-INSERT INTO parameterization (uuid, maxCreditsToOffer, numClassesPerDiscipline, semester_id, course_id)
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO parameterization (uuid, maxCreditsToOffer, numClassesPerDiscipline, semester_id, course_id, populationSize, generations, tournamentSize, mutationRate, hoursPerCredit, maxBlockHours, spreadWeight, sameDayWeight, idleGapWeight)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
 
 -- name: FindParameterizationByID :one
-SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
 FROM parameterization p
 WHERE p.uuid = $1;
 
+-- name: FindParameterizationByParameterizationId :one
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
+FROM parameterization p
+WHERE p.id = $1;
+
 -- name: UpdateParameterization :exec
 UPDATE parameterization SET
     maxCreditsToOffer = COALESCE(sqlc.narg('maxCreditsToOffer'), maxCreditsToOffer),
-    numClassesPerDiscipline = COALESCE(sqlc.narg('numClassesPerDiscipline'), numClassesPerDiscipline)
+    numClassesPerDiscipline = COALESCE(sqlc.narg('numClassesPerDiscipline'), numClassesPerDiscipline),
+    populationSize = COALESCE(sqlc.narg('populationSize'), populationSize),
+    generations = COALESCE(sqlc.narg('generations'), generations),
+    tournamentSize = COALESCE(sqlc.narg('tournamentSize'), tournamentSize),
+    mutationRate = COALESCE(sqlc.narg('mutationRate'), mutationRate),
+    hoursPerCredit = COALESCE(sqlc.narg('hoursPerCredit'), hoursPerCredit),
+    maxBlockHours = COALESCE(sqlc.narg('maxBlockHours'), maxBlockHours),
+    spreadWeight = COALESCE(sqlc.narg('spreadWeight'), spreadWeight),
+    sameDayWeight = COALESCE(sqlc.narg('sameDayWeight'), sameDayWeight),
+    idleGapWeight = COALESCE(sqlc.narg('idleGapWeight'), idleGapWeight)
 WHERE uuid = $1;
 
 -- name: DeleteParameterization :exec
 DELETE FROM parameterization WHERE uuid = $1;
 
 -- name: FindManyParameterizations :many
-SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
 FROM parameterization p
 ORDER BY p.semester_id, p.course_id ASC;
 
 -- name: FindManyParameterizationsBySemesterId :many
-SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
 FROM parameterization p
 WHERE p.semester_id = $1
 ORDER BY p.course_id ASC;
@@ -34,7 +48,7 @@ ORDER BY p.course_id ASC;
 
 
 -- name: GetDisciplinesByCourseID :many
-SELECT id, uuid, name, credits, course_id FROM discipline WHERE course_id = $1;
+SELECT id, uuid, name, credits, course_id, room_type, expected_enrolment, term, code FROM discipline WHERE course_id = $1;
 
 -- name: GetProfessorsByCourseID :many
 SELECT p.id, p.uuid, p.name, p.hoursToAllocate
@@ -44,12 +58,12 @@ FROM professor p
 WHERE d.course_id = $1;
 
 -- name: CreateProposal :exec
-INSERT INTO proposal (uuid, semester_id, course_id)
-VALUES ($1, $2, $3);
+INSERT INTO proposal (uuid, semester_id, course_id, seed, fitness, parameterization_id, population_size, generations, tournament_size, mutation_rate, fitness_breakdown)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
 
 -- name: CreateClass :exec
-INSERT INTO class (uuid, dayOfWeek, shift, startTime, endTime, discipline_id, professor_id, proposal_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, uuid;
+INSERT INTO class (uuid, dayOfWeek, shift, startTime, endTime, discipline_id, professor_id, proposal_id, section, room_id, locked)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, uuid;
 
 -- name: GetProposalID :one
 SELECT p.id from proposal p where p.uuid = $1;
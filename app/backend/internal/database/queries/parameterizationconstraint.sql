@@ -0,0 +1,28 @@
+-- name: CreateParameterizationConstraint :exec
+INSERT INTO parameterization_constraints (uuid, parameterization_id, name, enabled, weight)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: FindParameterizationConstraintByID :one
+SELECT pc.id, pc.uuid, pc.parameterization_id, pc.name, pc.enabled, pc.weight
+FROM parameterization_constraints pc
+WHERE pc.uuid = $1;
+
+-- name: FindParameterizationConstraintByName :one
+SELECT pc.id, pc.uuid, pc.parameterization_id, pc.name, pc.enabled, pc.weight
+FROM parameterization_constraints pc
+WHERE pc.parameterization_id = $1 AND pc.name = $2;
+
+-- name: UpdateParameterizationConstraint :exec
+UPDATE parameterization_constraints SET
+    enabled = $2,
+    weight = $3
+WHERE uuid = $1;
+
+-- name: DeleteParameterizationConstraint :exec
+DELETE FROM parameterization_constraints WHERE uuid = $1;
+
+-- name: FindManyParameterizationConstraintsByParameterizationId :many
+SELECT pc.id, pc.uuid, pc.parameterization_id, pc.name, pc.enabled, pc.weight
+FROM parameterization_constraints pc
+WHERE pc.parameterization_id = $1
+ORDER BY pc.name ASC;
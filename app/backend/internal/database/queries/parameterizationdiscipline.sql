@@ -0,0 +1,34 @@
+-- name: CreateParameterizationDiscipline :exec
+INSERT INTO parameterization_disciplines (uuid, parameterization_id, discipline_id, priority, mandatory)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: FindParameterizationDisciplineByID :one
+SELECT pd.id, pd.uuid, pd.parameterization_id, pd.discipline_id, pd.priority, pd.mandatory
+FROM parameterization_disciplines pd
+WHERE pd.uuid = $1;
+
+-- name: FindParameterizationDisciplineByDisciplineId :one
+SELECT pd.id, pd.uuid, pd.parameterization_id, pd.discipline_id, pd.priority, pd.mandatory
+FROM parameterization_disciplines pd
+WHERE pd.parameterization_id = $1 AND pd.discipline_id = $2;
+
+-- name: IsDisciplineOfParameterizationCourse :one
+SELECT EXISTS (SELECT 1
+               FROM parameterization pa
+                        JOIN discipline d ON d.course_id = pa.course_id
+               WHERE pa.id = sqlc.arg('parameterization_id') AND d.id = sqlc.arg('discipline_id'));
+
+-- name: UpdateParameterizationDiscipline :exec
+UPDATE parameterization_disciplines SET
+    priority = $2,
+    mandatory = $3
+WHERE uuid = $1;
+
+-- name: DeleteParameterizationDiscipline :exec
+DELETE FROM parameterization_disciplines WHERE uuid = $1;
+
+-- name: FindManyParameterizationDisciplinesByParameterizationId :many
+SELECT pd.id, pd.uuid, pd.parameterization_id, pd.discipline_id, pd.priority, pd.mandatory
+FROM parameterization_disciplines pd
+WHERE pd.parameterization_id = $1
+ORDER BY pd.mandatory DESC, pd.priority DESC, pd.id ASC;
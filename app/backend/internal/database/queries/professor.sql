@@ -40,6 +40,7 @@ FROM
         LEFT JOIN
     eligible_disciplines ed ON p.id = ed.professor_id
         LEFT JOIN
-    discipline d ON ed.discipline_id = d.id;
+    discipline d ON ed.discipline_id = d.id
+ORDER BY p.id, d.id;
 
 
@@ -0,0 +1,201 @@
+-- name: FindProposalByID :one
+SELECT p.id, p.uuid, p.semester_id, p.course_id, p.seed, p.fitness, p.parameterization_id,
+       p.population_size, p.generations, p.tournament_size, p.mutation_rate, p.created_at,
+       p.fitness_breakdown, p.status
+FROM proposal p
+WHERE p.uuid = $1;
+
+-- name: FindManyProposals :many
+SELECT p.id, p.uuid, p.semester_id, p.course_id, p.seed, p.fitness, p.parameterization_id,
+       p.population_size, p.generations, p.tournament_size, p.mutation_rate, p.created_at,
+       p.fitness_breakdown, p.status
+FROM proposal p
+WHERE (sqlc.narg('semester_id')::BIGINT IS NULL OR p.semester_id = sqlc.narg('semester_id'))
+  AND (sqlc.narg('course_id')::BIGINT IS NULL OR p.course_id = sqlc.narg('course_id'))
+  AND (sqlc.narg('status')::VARCHAR IS NULL OR p.status = sqlc.narg('status'))
+ORDER BY p.created_at DESC;
+
+-- name: FindClassesByProposalID :many
+SELECT c.id, c.uuid, c.dayOfWeek, c.shift, c.startTime, c.endTime, c.proposal_id, c.section, c.room_id, c.locked,
+       d.id AS discipline_id, d.uuid AS discipline_uuid, d.name AS discipline_name,
+       d.credits AS discipline_credits, d.course_id AS discipline_course_id,
+       d.room_type AS discipline_room_type, d.expected_enrolment AS discipline_expected_enrolment,
+       d.term AS discipline_term, d.code AS discipline_code,
+       pr.id AS professor_id, pr.uuid AS professor_uuid, pr.name AS professor_name,
+       pr.hoursToAllocate AS professor_hours_to_allocate,
+       r.uuid AS room_uuid, r.name AS room_name, r.capacity AS room_capacity,
+       r.type AS room_type, r.location AS room_location
+FROM class c
+         JOIN discipline d ON d.id = c.discipline_id
+         JOIN professor pr ON pr.id = c.professor_id
+         LEFT JOIN room r ON r.id = c.room_id
+WHERE c.proposal_id = $1
+  AND c.version_id IS NULL
+ORDER BY c.startTime, d.name, c.section;
+
+-- name: FindProposalSummaryByID :one
+SELECT p.id, p.uuid, p.semester_id, p.course_id, c.name AS course_name, s.semester AS semester_name,
+       pa.maxCreditsToOffer AS max_credits_to_offer
+FROM proposal p
+         JOIN course c ON c.id = p.course_id
+         JOIN semester s ON s.id = p.semester_id
+         LEFT JOIN parameterization pa ON pa.id = p.parameterization_id
+WHERE p.uuid = $1;
+
+-- name: FindLatestProposalSummariesBySemesterId :many
+SELECT DISTINCT ON (p.course_id) p.id, p.uuid, p.semester_id, p.course_id, c.name AS course_name,
+       s.semester AS semester_name, pa.maxCreditsToOffer AS max_credits_to_offer
+FROM proposal p
+         JOIN course c ON c.id = p.course_id
+         JOIN semester s ON s.id = p.semester_id
+         LEFT JOIN parameterization pa ON pa.id = p.parameterization_id
+WHERE p.semester_id = $1
+ORDER BY p.course_id, p.status IN ('approved', 'published') DESC, p.created_at DESC;
+
+-- name: FindProfessorWorkloadsByProposalIds :many
+SELECT pr.id, pr.uuid, pr.name, pr.hoursToAllocate,
+       COUNT(c.id) AS classes
+FROM professor pr
+         LEFT JOIN class c ON c.professor_id = pr.id AND c.proposal_id = ANY(sqlc.arg('proposal_ids')::BIGINT[])
+    AND c.version_id IS NULL
+WHERE c.id IS NOT NULL
+   OR pr.id IN (SELECT ed.professor_id
+                FROM eligible_disciplines ed
+                         JOIN discipline d ON d.id = ed.discipline_id
+                         JOIN proposal p ON p.course_id = d.course_id
+                WHERE p.id = ANY(sqlc.arg('proposal_ids')::BIGINT[]))
+GROUP BY pr.id, pr.uuid, pr.name, pr.hoursToAllocate
+ORDER BY pr.name;
+
+-- name: UpdateClass :exec
+UPDATE class
+SET dayOfWeek    = $2,
+    shift        = $3,
+    startTime    = $4,
+    endTime      = $5,
+    professor_id = $6,
+    room_id      = $7
+WHERE uuid = $1
+  AND version_id IS NULL;
+
+-- name: DeleteClass :exec
+DELETE FROM class WHERE uuid = $1 AND version_id IS NULL;
+
+-- name: UpdateProposalFitness :exec
+UPDATE proposal
+SET fitness           = $2,
+    fitness_breakdown = $3
+WHERE id = $1;
+
+-- name: UpdateProposalStatus :exec
+UPDATE proposal SET status = $2 WHERE id = $1;
+
+-- name: FindApprovedProposalsByCourseAndSemester :many
+SELECT p.uuid
+FROM proposal p
+WHERE p.course_id = $1
+  AND p.semester_id = $2
+  AND p.status IN ('approved', 'published');
+
+-- name: CreateProposalTransition :exec
+INSERT INTO proposal_transition (uuid, proposal_id, from_status, to_status, user_id, comment)
+VALUES (sqlc.arg('uuid'), sqlc.arg('proposal_id'), sqlc.arg('from_status'), sqlc.arg('to_status'),
+        (SELECT u.id FROM users u WHERE u.uuid = sqlc.arg('user_uuid')), sqlc.arg('comment'));
+
+-- name: FindManyProposalTransitionsByProposalId :many
+SELECT t.id, t.uuid, t.proposal_id, t.from_status, t.to_status, t.comment, t.created_at,
+       u.uuid AS user_uuid, u.name AS user_name
+FROM proposal_transition t
+         LEFT JOIN users u ON u.id = t.user_id
+WHERE t.proposal_id = $1
+ORDER BY t.created_at, t.id;
+
+
+-- name: CreateProposalVersion :one
+INSERT INTO proposal_version (uuid, proposal_id, version, user_id, reason, fitness, fitness_breakdown)
+SELECT sqlc.arg('uuid'), p.id,
+       COALESCE((SELECT MAX(v.version) FROM proposal_version v WHERE v.proposal_id = p.id), 0) + 1,
+       (SELECT u.id FROM users u WHERE u.uuid = sqlc.narg('user_uuid')), sqlc.arg('reason'), p.fitness, p.fitness_breakdown
+FROM proposal p
+WHERE p.id = sqlc.arg('proposal_id')
+RETURNING id, version;
+
+-- name: CopyClassesToVersion :exec
+INSERT INTO class (uuid, dayOfWeek, shift, startTime, endTime, discipline_id, professor_id, proposal_id, section, room_id, locked, version_id)
+SELECT c.uuid, c.dayOfWeek, c.shift, c.startTime, c.endTime, c.discipline_id, c.professor_id, c.proposal_id, c.section, c.room_id, c.locked,
+       sqlc.arg('version_id')
+FROM class c
+WHERE c.proposal_id = sqlc.arg('proposal_id')
+  AND c.version_id IS NULL;
+
+-- name: FindManyProposalVersionsByProposalId :many
+SELECT v.id, v.uuid, v.proposal_id, v.version, v.reason, v.fitness, v.fitness_breakdown, v.created_at,
+       u.uuid AS user_uuid, u.name AS user_name
+FROM proposal_version v
+         LEFT JOIN users u ON u.id = v.user_id
+WHERE v.proposal_id = $1
+ORDER BY v.version DESC;
+
+-- name: FindProposalVersion :one
+SELECT v.id, v.uuid, v.proposal_id, v.version, v.reason, v.fitness, v.fitness_breakdown, v.created_at,
+       u.uuid AS user_uuid, u.name AS user_name
+FROM proposal_version v
+         LEFT JOIN users u ON u.id = v.user_id
+WHERE v.proposal_id = $1
+  AND v.version = $2;
+
+-- name: FindClassesByVersionId :many
+SELECT c.id, c.uuid, c.dayOfWeek, c.shift, c.startTime, c.endTime, c.proposal_id, c.section, c.room_id, c.locked,
+       d.id AS discipline_id, d.uuid AS discipline_uuid, d.name AS discipline_name,
+       d.credits AS discipline_credits, d.course_id AS discipline_course_id,
+       d.room_type AS discipline_room_type, d.expected_enrolment AS discipline_expected_enrolment,
+       d.term AS discipline_term, d.code AS discipline_code,
+       pr.id AS professor_id, pr.uuid AS professor_uuid, pr.name AS professor_name,
+       pr.hoursToAllocate AS professor_hours_to_allocate,
+       r.uuid AS room_uuid, r.name AS room_name, r.capacity AS room_capacity,
+       r.type AS room_type, r.location AS room_location
+FROM class c
+         JOIN discipline d ON d.id = c.discipline_id
+         JOIN professor pr ON pr.id = c.professor_id
+         LEFT JOIN room r ON r.id = c.room_id
+WHERE c.version_id = $1
+ORDER BY c.startTime, d.name, c.section;
+
+-- name: DeleteProposalClasses :exec
+DELETE FROM class WHERE proposal_id = $1 AND version_id IS NULL;
+
+-- name: RestoreClassesFromVersion :exec
+INSERT INTO class (uuid, dayOfWeek, shift, startTime, endTime, discipline_id, professor_id, proposal_id, section, room_id, locked)
+SELECT c.uuid, c.dayOfWeek, c.shift, c.startTime, c.endTime, c.discipline_id, c.professor_id, c.proposal_id, c.section, c.room_id, c.locked
+FROM class c
+WHERE c.version_id = $1;
+
+-- name: FindApprovedProposalSummariesBySemesterId :many
+SELECT p.id, p.uuid, p.semester_id, p.course_id, c.name AS course_name, s.semester AS semester_name,
+       pa.maxCreditsToOffer AS max_credits_to_offer
+FROM proposal p
+         JOIN course c ON c.id = p.course_id
+         JOIN semester s ON s.id = p.semester_id
+         LEFT JOIN parameterization pa ON pa.id = p.parameterization_id
+WHERE p.semester_id = $1
+  AND p.status IN ('approved', 'published')
+ORDER BY p.course_id;
+
+-- name: FindClassesByProfessorAndProposalIds :many
+SELECT c.id, c.uuid, c.dayOfWeek, c.shift, c.startTime, c.endTime, c.proposal_id, c.section, c.room_id, c.locked,
+       d.id AS discipline_id, d.uuid AS discipline_uuid, d.name AS discipline_name,
+       d.credits AS discipline_credits, d.course_id AS discipline_course_id,
+       d.room_type AS discipline_room_type, d.expected_enrolment AS discipline_expected_enrolment,
+       d.term AS discipline_term, d.code AS discipline_code,
+       pr.id AS professor_id, pr.uuid AS professor_uuid, pr.name AS professor_name,
+       pr.hoursToAllocate AS professor_hours_to_allocate,
+       r.uuid AS room_uuid, r.name AS room_name, r.capacity AS room_capacity,
+       r.type AS room_type, r.location AS room_location
+FROM class c
+         JOIN discipline d ON d.id = c.discipline_id
+         JOIN professor pr ON pr.id = c.professor_id
+         LEFT JOIN room r ON r.id = c.room_id
+WHERE c.professor_id = sqlc.arg('professor_id')
+  AND c.proposal_id = ANY(sqlc.arg('proposal_ids')::BIGINT[])
+  AND c.version_id IS NULL
+ORDER BY c.startTime, d.name, c.section;
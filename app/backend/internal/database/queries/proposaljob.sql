@@ -0,0 +1,68 @@
+-- name: CreateProposalJob :exec
+INSERT INTO proposal_job (uuid, status, seed, parameterization_id)
+VALUES ($1, $2, $3, $4);
+
+-- name: FindProposalJobByID :one
+SELECT j.id, j.uuid, j.status, j.progress, j.error, j.created_at, j.started_at, j.finished_at, j.seed,
+       j.parameterization_id, pa.uuid AS parameterization_uuid, p.uuid AS proposal_uuid
+FROM proposal_job j
+         JOIN parameterization pa ON pa.id = j.parameterization_id
+         LEFT JOIN proposal p ON p.id = j.proposal_id
+WHERE j.uuid = $1;
+
+-- name: FindManyProposalJobs :many
+SELECT j.id, j.uuid, j.status, j.progress, j.error, j.created_at, j.started_at, j.finished_at, j.seed,
+       j.parameterization_id, pa.uuid AS parameterization_uuid, p.uuid AS proposal_uuid
+FROM proposal_job j
+         JOIN parameterization pa ON pa.id = j.parameterization_id
+         LEFT JOIN proposal p ON p.id = j.proposal_id
+ORDER BY j.created_at DESC;
+
+-- name: FindManyProposalJobsByParameterizationId :many
+SELECT j.id, j.uuid, j.status, j.progress, j.error, j.created_at, j.started_at, j.finished_at, j.seed,
+       j.parameterization_id, pa.uuid AS parameterization_uuid, p.uuid AS proposal_uuid
+FROM proposal_job j
+         JOIN parameterization pa ON pa.id = j.parameterization_id
+         LEFT JOIN proposal p ON p.id = j.proposal_id
+WHERE j.parameterization_id = $1
+ORDER BY j.created_at DESC;
+
+-- name: FindManyProposalJobsByStatus :many
+SELECT j.id, j.uuid, j.status, j.progress, j.error, j.created_at, j.started_at, j.finished_at, j.seed,
+       j.parameterization_id, pa.uuid AS parameterization_uuid, p.uuid AS proposal_uuid
+FROM proposal_job j
+         JOIN parameterization pa ON pa.id = j.parameterization_id
+         LEFT JOIN proposal p ON p.id = j.proposal_id
+WHERE j.status = $1
+ORDER BY j.created_at ASC;
+
+-- name: ClaimProposalJob :one
+WITH claimed AS (
+    UPDATE proposal_job SET
+        status = sqlc.arg('status'),
+        progress = 0,
+        started_at = now()
+    WHERE id = (SELECT id
+                FROM proposal_job
+                WHERE status = sqlc.arg('pending_status')
+                ORDER BY created_at
+                LIMIT 1 FOR UPDATE SKIP LOCKED)
+    RETURNING id, uuid, status, progress, error, created_at, started_at, finished_at, seed, parameterization_id, proposal_id
+)
+SELECT j.id, j.uuid, j.status, j.progress, j.error, j.created_at, j.started_at, j.finished_at, j.seed,
+       j.parameterization_id, pa.uuid AS parameterization_uuid, p.uuid AS proposal_uuid
+FROM claimed j
+         JOIN parameterization pa ON pa.id = j.parameterization_id
+         LEFT JOIN proposal p ON p.id = j.proposal_id;
+
+-- name: UpdateProposalJobProgress :exec
+UPDATE proposal_job SET progress = $2 WHERE uuid = $1;
+
+-- name: FinishProposalJob :exec
+UPDATE proposal_job SET
+    status = $2,
+    progress = $3,
+    error = $4,
+    proposal_id = $5,
+    finished_at = now()
+WHERE uuid = $1;
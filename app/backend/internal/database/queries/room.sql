@@ -0,0 +1,31 @@
+-- name: CreateRoom :exec
+INSERT INTO room (uuid, name, capacity, type, location)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: FindRoomByID :one
+SELECT r.id, r.uuid, r.name, r.capacity, r.type, r.location
+FROM room r
+WHERE r.uuid = $1;
+
+-- name: UpdateRoom :exec
+UPDATE room SET
+    name = COALESCE(sqlc.narg('name'), name),
+    capacity = COALESCE(sqlc.narg('capacity'), capacity),
+    type = COALESCE(sqlc.narg('type'), type),
+    location = COALESCE(sqlc.narg('location'), location)
+WHERE uuid = $1;
+
+-- name: DeleteRoom :exec
+DELETE FROM room WHERE uuid = $1;
+
+-- name: FindManyRooms :many
+SELECT r.id, r.uuid, r.name, r.capacity, r.type, r.location
+FROM room r
+ORDER BY r.location, r.name ASC;
+
+-- name: FindManyRoomsByCourseId :many
+SELECT r.id, r.uuid, r.name, r.capacity, r.type, r.location
+FROM room r
+         JOIN course c ON c.location = r.location
+WHERE c.id = sqlc.arg('course_id')
+ORDER BY r.name ASC;
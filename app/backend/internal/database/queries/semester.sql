@@ -2,25 +2,59 @@
 SELECT * from semester s where s.uuid = $1;
 
 -- name: CreateSemester :exec
-INSERT INTO semester (uuid, semester)
-VALUES ($1, $2);
+INSERT INTO semester (uuid, semester, start_date, end_date)
+VALUES ($1, $2, $3, $4);
 
 -- name: FindSemesterByID :one
-SELECT s.id, s.uuid, s.semester
+SELECT s.id, s.uuid, s.semester, s.start_date, s.end_date
 FROM semester s
 WHERE s.uuid = $1;
 
+-- name: FindSemesterBySemesterId :one
+SELECT s.id, s.uuid, s.semester, s.start_date, s.end_date
+FROM semester s
+WHERE s.id = $1;
+
 -- name: UpdateSemester :exec
 UPDATE semester SET
-    semester = COALESCE(sqlc.narg('semester'), semester)
+    semester = COALESCE(sqlc.narg('semester'), semester),
+    start_date = COALESCE(sqlc.narg('start_date'), start_date),
+    end_date = COALESCE(sqlc.narg('end_date'), end_date)
 WHERE uuid = $1;
 
 -- name: DeleteSemester :exec
 DELETE FROM semester WHERE uuid = $1;
 
 -- name: FindManySemesters :many
-SELECT s.id, s.uuid, s.semester
+SELECT s.id, s.uuid, s.semester, s.start_date, s.end_date
 FROM semester s
 ORDER BY s.semester ASC;
 
+-- name: CreateSemesterHoliday :exec
+INSERT INTO semester_holiday (uuid, semester_id, date, description)
+VALUES ($1, $2, $3, $4);
+
+-- name: FindSemesterHolidayByID :one
+SELECT sh.id, sh.uuid, sh.semester_id, sh.date, sh.description
+FROM semester_holiday sh
+WHERE sh.uuid = $1;
+
+-- name: FindSemesterHolidayByDate :one
+SELECT sh.id, sh.uuid, sh.semester_id, sh.date, sh.description
+FROM semester_holiday sh
+WHERE sh.semester_id = $1 AND sh.date = $2;
+
+-- name: UpdateSemesterHoliday :exec
+UPDATE semester_holiday SET
+    date = COALESCE(sqlc.narg('date'), date),
+    description = COALESCE(sqlc.narg('description'), description)
+WHERE uuid = $1;
+
+-- name: DeleteSemesterHoliday :exec
+DELETE FROM semester_holiday WHERE uuid = $1;
 
+-- name: FindManySemesterHolidaysBySemesterId :many
+SELECT sh.id, sh.uuid, sh.semester_id, sh.date, sh.description
+FROM semester_holiday sh
+WHERE sh.semester_id = $1
+ORDER BY sh.date ASC;
@@ -0,0 +1,37 @@
+-- name: CreateShiftHours :exec
+INSERT INTO shift_hours (uuid, shift, start_time, end_time, course_id, location)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: FindShiftHoursByID :one
+SELECT sh.id, sh.uuid, sh.shift, sh.start_time, sh.end_time, sh.course_id, sh.location
+FROM shift_hours sh
+WHERE sh.uuid = $1;
+
+-- name: FindShiftHoursByScope :one
+SELECT sh.id, sh.uuid, sh.shift, sh.start_time, sh.end_time, sh.course_id, sh.location
+FROM shift_hours sh
+WHERE sh.shift = sqlc.arg('shift')
+  AND sh.course_id IS NOT DISTINCT FROM sqlc.narg('course_id')
+  AND sh.location IS NOT DISTINCT FROM sqlc.narg('location');
+
+-- name: UpdateShiftHours :exec
+UPDATE shift_hours SET
+    start_time = $2,
+    end_time = $3
+WHERE uuid = $1;
+
+-- name: DeleteShiftHours :exec
+DELETE FROM shift_hours WHERE uuid = $1;
+
+-- name: FindManyShiftHours :many
+SELECT sh.id, sh.uuid, sh.shift, sh.start_time, sh.end_time, sh.course_id, sh.location
+FROM shift_hours sh
+ORDER BY sh.course_id NULLS FIRST, sh.location NULLS FIRST, sh.start_time ASC;
+
+-- name: FindShiftHoursForCourse :many
+SELECT sh.id, sh.uuid, sh.shift, sh.start_time, sh.end_time, sh.course_id, sh.location
+FROM shift_hours sh
+         JOIN course c ON c.id = sqlc.arg('course_id')
+WHERE sh.course_id = c.id
+   OR (sh.course_id IS NULL AND (sh.location IS NULL OR sh.location = c.location))
+ORDER BY sh.start_time ASC;
@@ -0,0 +1,30 @@
+-- name: CreateTimeSlot :exec
+INSERT INTO time_slot (uuid, label, shift, start_time, end_time, weekdays)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: FindTimeSlotByID :one
+SELECT ts.id, ts.uuid, ts.label, ts.shift, ts.start_time, ts.end_time, ts.weekdays
+FROM time_slot ts
+WHERE ts.uuid = $1;
+
+-- name: FindTimeSlotByLabel :one
+SELECT ts.id, ts.uuid, ts.label, ts.shift, ts.start_time, ts.end_time, ts.weekdays
+FROM time_slot ts
+WHERE ts.label = $1;
+
+-- name: UpdateTimeSlot :exec
+UPDATE time_slot SET
+    label = $2,
+    shift = $3,
+    start_time = $4,
+    end_time = $5,
+    weekdays = $6
+WHERE uuid = $1;
+
+-- name: DeleteTimeSlot :exec
+DELETE FROM time_slot WHERE uuid = $1;
+
+-- name: FindManyTimeSlots :many
+SELECT ts.id, ts.uuid, ts.label, ts.shift, ts.start_time, ts.end_time, ts.weekdays
+FROM time_slot ts
+ORDER BY ts.start_time ASC, ts.label ASC;
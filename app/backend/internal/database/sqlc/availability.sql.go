@@ -8,19 +8,21 @@ package sqlc
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 const createAvailability = `-- name: CreateAvailability :exec
-INSERT INTO availability (uuid, dayOfWeek, shift, professor_id)
-VALUES ($1, $2, $3, $4)
+INSERT INTO availability (uuid, dayOfWeek, start_time, end_time, professor_id)
+VALUES ($1, $2, $3, $4, $5)
 `
 
 type CreateAvailabilityParams struct {
 	Uuid        uuid.UUID
 	Dayofweek   string
-	Shift       string
+	StartTime   time.Time
+	EndTime     time.Time
 	ProfessorID int64
 }
 
@@ -28,7 +30,8 @@ func (q *Queries) CreateAvailability(ctx context.Context, arg CreateAvailability
 	_, err := q.db.ExecContext(ctx, createAvailability,
 		arg.Uuid,
 		arg.Dayofweek,
-		arg.Shift,
+		arg.StartTime,
+		arg.EndTime,
 		arg.ProfessorID,
 	)
 	return err
@@ -44,7 +47,7 @@ func (q *Queries) DeleteAvailability(ctx context.Context, argUuid uuid.UUID) err
 }
 
 const findAvailabilityByID = `-- name: FindAvailabilityByID :one
-SELECT a.id, a.uuid, a.dayOfWeek, a.shift, a.professor_id
+SELECT a.id, a.uuid, a.dayOfWeek, a.professor_id, a.start_time, a.end_time
 FROM availability a
 WHERE a.uuid = $1
 `
@@ -56,16 +59,17 @@ func (q *Queries) FindAvailabilityByID(ctx context.Context, argUuid uuid.UUID) (
 		&i.ID,
 		&i.Uuid,
 		&i.Dayofweek,
-		&i.Shift,
 		&i.ProfessorID,
+		&i.StartTime,
+		&i.EndTime,
 	)
 	return i, err
 }
 
 const findManyAvailabilities = `-- name: FindManyAvailabilities :many
-SELECT a.id, a.uuid, a.dayOfWeek, a.shift, a.professor_id
+SELECT a.id, a.uuid, a.dayOfWeek, a.professor_id, a.start_time, a.end_time
 FROM availability a
-ORDER BY a.dayOfWeek, a.shift ASC
+ORDER BY a.dayOfWeek, a.start_time ASC
 `
 
 func (q *Queries) FindManyAvailabilities(ctx context.Context) ([]Availability, error) {
@@ -81,8 +85,9 @@ func (q *Queries) FindManyAvailabilities(ctx context.Context) ([]Availability, e
 			&i.ID,
 			&i.Uuid,
 			&i.Dayofweek,
-			&i.Shift,
 			&i.ProfessorID,
+			&i.StartTime,
+			&i.EndTime,
 		); err != nil {
 			return nil, err
 		}
@@ -98,10 +103,10 @@ func (q *Queries) FindManyAvailabilities(ctx context.Context) ([]Availability, e
 }
 
 const findManyAvailabilitiesByProfessorId = `-- name: FindManyAvailabilitiesByProfessorId :many
-SELECT a.id, a.uuid, a.dayOfWeek, a.shift, a.professor_id
+SELECT a.id, a.uuid, a.dayOfWeek, a.professor_id, a.start_time, a.end_time
 FROM availability a
 WHERE a.professor_id = $1
-ORDER BY a.professor_id, a.dayOfWeek, a.shift ASC
+ORDER BY a.professor_id, a.dayOfWeek, a.start_time ASC
 `
 
 func (q *Queries) FindManyAvailabilitiesByProfessorId(ctx context.Context, professorID int64) ([]Availability, error) {
@@ -117,8 +122,9 @@ func (q *Queries) FindManyAvailabilitiesByProfessorId(ctx context.Context, profe
 			&i.ID,
 			&i.Uuid,
 			&i.Dayofweek,
-			&i.Shift,
 			&i.ProfessorID,
+			&i.StartTime,
+			&i.EndTime,
 		); err != nil {
 			return nil, err
 		}
@@ -134,7 +140,7 @@ func (q *Queries) FindManyAvailabilitiesByProfessorId(ctx context.Context, profe
 }
 
 const getAvailabilityByID = `-- name: GetAvailabilityByID :one
-SELECT id, uuid, dayofweek, shift, professor_id from availability a where a.uuid = $1
+SELECT id, uuid, dayofweek, professor_id, start_time, end_time from availability a where a.uuid = $1
 `
 
 func (q *Queries) GetAvailabilityByID(ctx context.Context, argUuid uuid.UUID) (Availability, error) {
@@ -144,26 +150,34 @@ func (q *Queries) GetAvailabilityByID(ctx context.Context, argUuid uuid.UUID) (A
 		&i.ID,
 		&i.Uuid,
 		&i.Dayofweek,
-		&i.Shift,
 		&i.ProfessorID,
+		&i.StartTime,
+		&i.EndTime,
 	)
 	return i, err
 }
 
 const updateAvailability = `-- name: UpdateAvailability :exec
 UPDATE availability SET
-    dayOfWeek = COALESCE($2, dayOfWeek),
-    shift = COALESCE($3, shift)
+    dayOfWeek = COALESCE($1, dayOfWeek),
+    start_time = COALESCE($2, start_time),
+    end_time = COALESCE($3, end_time)
 WHERE uuid = $1
 `
 
 type UpdateAvailabilityParams struct {
 	Uuid      uuid.UUID
 	DayOfWeek sql.NullString
-	Shift     sql.NullString
+	StartTime sql.NullTime
+	EndTime   sql.NullTime
 }
 
 func (q *Queries) UpdateAvailability(ctx context.Context, arg UpdateAvailabilityParams) error {
-	_, err := q.db.ExecContext(ctx, updateAvailability, arg.Uuid, arg.DayOfWeek, arg.Shift)
+	_, err := q.db.ExecContext(ctx, updateAvailability,
+		arg.Uuid,
+		arg.DayOfWeek,
+		arg.StartTime,
+		arg.EndTime,
+	)
 	return err
 }
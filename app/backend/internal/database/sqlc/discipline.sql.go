@@ -13,15 +13,19 @@ import (
 )
 
 const createDiscipline = `-- name: CreateDiscipline :exec
-INSERT INTO discipline (uuid, name, credits, course_id)
-VALUES ($1, $2, $3, $4)
+INSERT INTO discipline (uuid, name, credits, course_id, room_type, expected_enrolment, term, code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateDisciplineParams struct {
-	Uuid     uuid.UUID
-	Name     string
-	Credits  int32
-	CourseID int64
+	Uuid              uuid.UUID
+	Name              string
+	Credits           int32
+	CourseID          int64
+	RoomType          string
+	ExpectedEnrolment int32
+	Term              int32
+	Code              string
 }
 
 func (q *Queries) CreateDiscipline(ctx context.Context, arg CreateDisciplineParams) error {
@@ -30,6 +34,10 @@ func (q *Queries) CreateDiscipline(ctx context.Context, arg CreateDisciplinePara
 		arg.Name,
 		arg.Credits,
 		arg.CourseID,
+		arg.RoomType,
+		arg.ExpectedEnrolment,
+		arg.Term,
+		arg.Code,
 	)
 	return err
 }
@@ -43,8 +51,31 @@ func (q *Queries) DeleteDiscipline(ctx context.Context, argUuid uuid.UUID) error
 	return err
 }
 
+const findDisciplineByCode = `-- name: FindDisciplineByCode :one
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
+FROM discipline d
+WHERE d.code = $1
+`
+
+func (q *Queries) FindDisciplineByCode(ctx context.Context, code string) (Discipline, error) {
+	row := q.db.QueryRowContext(ctx, findDisciplineByCode, code)
+	var i Discipline
+	err := row.Scan(
+		&i.ID,
+		&i.Uuid,
+		&i.Name,
+		&i.Credits,
+		&i.CourseID,
+		&i.RoomType,
+		&i.ExpectedEnrolment,
+		&i.Term,
+		&i.Code,
+	)
+	return i, err
+}
+
 const findDisciplineByID = `-- name: FindDisciplineByID :one
-SELECT d.id, d.uuid, d.name, d.credits, d.course_id
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
 FROM discipline d
 WHERE d.uuid = $1
 `
@@ -58,12 +89,16 @@ func (q *Queries) FindDisciplineByID(ctx context.Context, argUuid uuid.UUID) (Di
 		&i.Name,
 		&i.Credits,
 		&i.CourseID,
+		&i.RoomType,
+		&i.ExpectedEnrolment,
+		&i.Term,
+		&i.Code,
 	)
 	return i, err
 }
 
 const findManyDisciplines = `-- name: FindManyDisciplines :many
-SELECT d.id, d.uuid, d.name, d.credits, d.course_id
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
 FROM discipline d
 ORDER BY d.course_id, d.name ASC
 `
@@ -83,6 +118,10 @@ func (q *Queries) FindManyDisciplines(ctx context.Context) ([]Discipline, error)
 			&i.Name,
 			&i.Credits,
 			&i.CourseID,
+			&i.RoomType,
+			&i.ExpectedEnrolment,
+			&i.Term,
+			&i.Code,
 		); err != nil {
 			return nil, err
 		}
@@ -98,7 +137,7 @@ func (q *Queries) FindManyDisciplines(ctx context.Context) ([]Discipline, error)
 }
 
 const findManyDisciplinesByCourseId = `-- name: FindManyDisciplinesByCourseId :many
-SELECT d.id, d.uuid, d.name, d.credits, d.course_id
+SELECT d.id, d.uuid, d.name, d.credits, d.course_id, d.room_type, d.expected_enrolment, d.term, d.code
 FROM discipline d
 WHERE d.course_id = $1
 ORDER BY d.name ASC
@@ -119,6 +158,10 @@ func (q *Queries) FindManyDisciplinesByCourseId(ctx context.Context, courseID in
 			&i.Name,
 			&i.Credits,
 			&i.CourseID,
+			&i.RoomType,
+			&i.ExpectedEnrolment,
+			&i.Term,
+			&i.Code,
 		); err != nil {
 			return nil, err
 		}
@@ -134,7 +177,7 @@ func (q *Queries) FindManyDisciplinesByCourseId(ctx context.Context, courseID in
 }
 
 const getDisciplineByID = `-- name: GetDisciplineByID :one
-SELECT id, uuid, name, credits, course_id from discipline d where d.uuid = $1
+SELECT id, uuid, name, credits, course_id, room_type, expected_enrolment, term, code from discipline d where d.uuid = $1
 `
 
 func (q *Queries) GetDisciplineByID(ctx context.Context, argUuid uuid.UUID) (Discipline, error) {
@@ -146,24 +189,44 @@ func (q *Queries) GetDisciplineByID(ctx context.Context, argUuid uuid.UUID) (Dis
 		&i.Name,
 		&i.Credits,
 		&i.CourseID,
+		&i.RoomType,
+		&i.ExpectedEnrolment,
+		&i.Term,
+		&i.Code,
 	)
 	return i, err
 }
 
 const updateDiscipline = `-- name: UpdateDiscipline :exec
 UPDATE discipline SET
-    name = COALESCE($2, name),
-    credits = COALESCE($3, credits)
+    name = COALESCE($1, name),
+    credits = COALESCE($2, credits),
+    room_type = COALESCE($3, room_type),
+    expected_enrolment = COALESCE($4, expected_enrolment),
+    term = COALESCE($5, term),
+    code = COALESCE($6, code)
 WHERE uuid = $1
 `
 
 type UpdateDisciplineParams struct {
-	Uuid    uuid.UUID
-	Name    sql.NullString
-	Credits sql.NullInt32
+	Uuid              uuid.UUID
+	Name              sql.NullString
+	Credits           sql.NullInt32
+	RoomType          sql.NullString
+	ExpectedEnrolment sql.NullInt32
+	Term              sql.NullInt32
+	Code              sql.NullString
 }
 
 func (q *Queries) UpdateDiscipline(ctx context.Context, arg UpdateDisciplineParams) error {
-	_, err := q.db.ExecContext(ctx, updateDiscipline, arg.Uuid, arg.Name, arg.Credits)
+	_, err := q.db.ExecContext(ctx, updateDiscipline,
+		arg.Uuid,
+		arg.Name,
+		arg.Credits,
+		arg.RoomType,
+		arg.ExpectedEnrolment,
+		arg.Term,
+		arg.Code,
+	)
 	return err
 }
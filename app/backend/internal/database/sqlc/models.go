@@ -5,6 +5,8 @@
 package sqlc
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,8 +16,9 @@ type Availability struct {
 	ID          int64
 	Uuid        uuid.UUID
 	Dayofweek   string
-	Shift       string
 	ProfessorID int64
+	StartTime   time.Time
+	EndTime     time.Time
 }
 
 type Class struct {
@@ -28,6 +31,10 @@ type Class struct {
 	DisciplineID int64
 	ProfessorID  int64
 	ProposalID   int64
+	Section      int32
+	RoomID       sql.NullInt64
+	Locked       bool
+	VersionID    sql.NullInt64
 }
 
 type Course struct {
@@ -38,12 +45,33 @@ type Course struct {
 	Location string
 }
 
-type Discipline struct {
+type CurriculumMatrix struct {
 	ID       int64
 	Uuid     uuid.UUID
-	Name     string
-	Credits  int32
 	CourseID int64
+	Version  string
+	Active   bool
+}
+
+type CurriculumMatrixDiscipline struct {
+	ID                 int64
+	Uuid               uuid.UUID
+	CurriculumMatrixID int64
+	DisciplineID       int64
+	Term               int32
+	Mandatory          bool
+}
+
+type Discipline struct {
+	ID                int64
+	Uuid              uuid.UUID
+	Name              string
+	Credits           int32
+	CourseID          int64
+	RoomType          string
+	ExpectedEnrolment int32
+	Term              int32
+	Code              string
 }
 
 type EligibleDiscipline struct {
@@ -52,6 +80,19 @@ type EligibleDiscipline struct {
 	DisciplineID int64
 }
 
+type LockedClass struct {
+	ID                 int64
+	Uuid               uuid.UUID
+	ParameterizationID int64
+	DisciplineID       int64
+	ProfessorID        int64
+	RoomID             sql.NullInt64
+	Section            int32
+	DayOfWeek          string
+	StartTime          time.Time
+	EndTime            time.Time
+}
+
 type Parameterization struct {
 	ID                      int64
 	Uuid                    uuid.UUID
@@ -59,6 +100,33 @@ type Parameterization struct {
 	Numclassesperdiscipline int32
 	SemesterID              int64
 	CourseID                int64
+	Populationsize          int32
+	Generations             int32
+	Tournamentsize          int32
+	Mutationrate            float64
+	Hourspercredit          int32
+	Maxblockhours           int32
+	Spreadweight            float64
+	Samedayweight           float64
+	Idlegapweight           float64
+}
+
+type ParameterizationConstraint struct {
+	ID                 int64
+	Uuid               uuid.UUID
+	ParameterizationID int64
+	Name               string
+	Enabled            bool
+	Weight             float64
+}
+
+type ParameterizationDiscipline struct {
+	ID                 int64
+	Uuid               uuid.UUID
+	ParameterizationID int64
+	DisciplineID       int64
+	Priority           int32
+	Mandatory          bool
 }
 
 type Professor struct {
@@ -69,16 +137,102 @@ type Professor struct {
 }
 
 type Proposal struct {
+	ID                 int64
+	Uuid               uuid.UUID
+	SemesterID         int64
+	CourseID           int64
+	Seed               sql.NullInt64
+	Fitness            sql.NullFloat64
+	ParameterizationID sql.NullInt64
+	PopulationSize     sql.NullInt32
+	Generations        sql.NullInt32
+	TournamentSize     sql.NullInt32
+	MutationRate       sql.NullFloat64
+	CreatedAt          time.Time
+	FitnessBreakdown   json.RawMessage
+	Status             string
+}
+
+type ProposalJob struct {
+	ID                 int64
+	Uuid               uuid.UUID
+	Status             string
+	Progress           int32
+	Error              sql.NullString
+	CreatedAt          time.Time
+	StartedAt          sql.NullTime
+	FinishedAt         sql.NullTime
+	ParameterizationID int64
+	ProposalID         sql.NullInt64
+	Seed               sql.NullInt64
+}
+
+type ProposalTransition struct {
 	ID         int64
 	Uuid       uuid.UUID
-	SemesterID int64
-	CourseID   int64
+	ProposalID int64
+	FromStatus string
+	ToStatus   string
+	UserID     sql.NullInt64
+	Comment    sql.NullString
+	CreatedAt  time.Time
 }
 
-type Semester struct {
+type ProposalVersion struct {
+	ID               int64
+	Uuid             uuid.UUID
+	ProposalID       int64
+	Version          int32
+	UserID           sql.NullInt64
+	Reason           sql.NullString
+	Fitness          sql.NullFloat64
+	FitnessBreakdown json.RawMessage
+	CreatedAt        time.Time
+}
+
+type Room struct {
 	ID       int64
 	Uuid     uuid.UUID
-	Semester string
+	Name     string
+	Capacity int32
+	Type     string
+	Location string
+}
+
+type Semester struct {
+	ID        int64
+	Uuid      uuid.UUID
+	Semester  string
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
+}
+
+type SemesterHoliday struct {
+	ID          int64
+	Uuid        uuid.UUID
+	SemesterID  int64
+	Date        time.Time
+	Description string
+}
+
+type ShiftHour struct {
+	ID        int64
+	Uuid      uuid.UUID
+	Shift     string
+	StartTime time.Time
+	EndTime   time.Time
+	CourseID  sql.NullInt64
+	Location  sql.NullString
+}
+
+type TimeSlot struct {
+	ID        int64
+	Uuid      uuid.UUID
+	Label     string
+	Shift     string
+	StartTime time.Time
+	EndTime   time.Time
+	Weekdays  []string
 }
 
 type User struct {
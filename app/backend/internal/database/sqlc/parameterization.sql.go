@@ -8,14 +8,15 @@ package sqlc
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 const createClass = `-- name: CreateClass :exec
-INSERT INTO class (uuid, dayOfWeek, shift, startTime, endTime, discipline_id, professor_id, proposal_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, uuid
+INSERT INTO class (uuid, dayOfWeek, shift, startTime, endTime, discipline_id, professor_id, proposal_id, section, room_id, locked)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, uuid
 `
 
 type CreateClassParams struct {
@@ -27,6 +28,9 @@ type CreateClassParams struct {
 	DisciplineID int64
 	ProfessorID  int64
 	ProposalID   int64
+	Section      int32
+	RoomID       sql.NullInt64
+	Locked       bool
 }
 
 func (q *Queries) CreateClass(ctx context.Context, arg CreateClassParams) error {
@@ -39,13 +43,16 @@ func (q *Queries) CreateClass(ctx context.Context, arg CreateClassParams) error
 		arg.DisciplineID,
 		arg.ProfessorID,
 		arg.ProposalID,
+		arg.Section,
+		arg.RoomID,
+		arg.Locked,
 	)
 	return err
 }
 
 const createParameterization = `-- name: CreateParameterization :exec
-INSERT INTO parameterization (uuid, maxCreditsToOffer, numClassesPerDiscipline, semester_id, course_id)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO parameterization (uuid, maxCreditsToOffer, numClassesPerDiscipline, semester_id, course_id, populationSize, generations, tournamentSize, mutationRate, hoursPerCredit, maxBlockHours, spreadWeight, sameDayWeight, idleGapWeight)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 `
 
 type CreateParameterizationParams struct {
@@ -54,6 +61,15 @@ type CreateParameterizationParams struct {
 	Numclassesperdiscipline int32
 	SemesterID              int64
 	CourseID                int64
+	Populationsize          int32
+	Generations             int32
+	Tournamentsize          int32
+	Mutationrate            float64
+	Hourspercredit          int32
+	Maxblockhours           int32
+	Spreadweight            float64
+	Samedayweight           float64
+	Idlegapweight           float64
 }
 
 func (q *Queries) CreateParameterization(ctx context.Context, arg CreateParameterizationParams) error {
@@ -63,23 +79,52 @@ func (q *Queries) CreateParameterization(ctx context.Context, arg CreateParamete
 		arg.Numclassesperdiscipline,
 		arg.SemesterID,
 		arg.CourseID,
+		arg.Populationsize,
+		arg.Generations,
+		arg.Tournamentsize,
+		arg.Mutationrate,
+		arg.Hourspercredit,
+		arg.Maxblockhours,
+		arg.Spreadweight,
+		arg.Samedayweight,
+		arg.Idlegapweight,
 	)
 	return err
 }
 
 const createProposal = `-- name: CreateProposal :exec
-INSERT INTO proposal (uuid, semester_id, course_id)
-VALUES ($1, $2, $3)
+INSERT INTO proposal (uuid, semester_id, course_id, seed, fitness, parameterization_id, population_size, generations, tournament_size, mutation_rate, fitness_breakdown)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 `
 
 type CreateProposalParams struct {
-	Uuid       uuid.UUID
-	SemesterID int64
-	CourseID   int64
+	Uuid               uuid.UUID
+	SemesterID         int64
+	CourseID           int64
+	Seed               sql.NullInt64
+	Fitness            sql.NullFloat64
+	ParameterizationID sql.NullInt64
+	PopulationSize     sql.NullInt32
+	Generations        sql.NullInt32
+	TournamentSize     sql.NullInt32
+	MutationRate       sql.NullFloat64
+	FitnessBreakdown   json.RawMessage
 }
 
 func (q *Queries) CreateProposal(ctx context.Context, arg CreateProposalParams) error {
-	_, err := q.db.ExecContext(ctx, createProposal, arg.Uuid, arg.SemesterID, arg.CourseID)
+	_, err := q.db.ExecContext(ctx, createProposal,
+		arg.Uuid,
+		arg.SemesterID,
+		arg.CourseID,
+		arg.Seed,
+		arg.Fitness,
+		arg.ParameterizationID,
+		arg.PopulationSize,
+		arg.Generations,
+		arg.TournamentSize,
+		arg.MutationRate,
+		arg.FitnessBreakdown,
+	)
 	return err
 }
 
@@ -93,7 +138,7 @@ func (q *Queries) DeleteParameterization(ctx context.Context, argUuid uuid.UUID)
 }
 
 const findManyParameterizations = `-- name: FindManyParameterizations :many
-SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
 FROM parameterization p
 ORDER BY p.semester_id, p.course_id ASC
 `
@@ -114,6 +159,15 @@ func (q *Queries) FindManyParameterizations(ctx context.Context) ([]Parameteriza
 			&i.Numclassesperdiscipline,
 			&i.SemesterID,
 			&i.CourseID,
+			&i.Populationsize,
+			&i.Generations,
+			&i.Tournamentsize,
+			&i.Mutationrate,
+			&i.Hourspercredit,
+			&i.Maxblockhours,
+			&i.Spreadweight,
+			&i.Samedayweight,
+			&i.Idlegapweight,
 		); err != nil {
 			return nil, err
 		}
@@ -129,7 +183,7 @@ func (q *Queries) FindManyParameterizations(ctx context.Context) ([]Parameteriza
 }
 
 const findManyParameterizationsBySemesterId = `-- name: FindManyParameterizationsBySemesterId :many
-SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
 FROM parameterization p
 WHERE p.semester_id = $1
 ORDER BY p.course_id ASC
@@ -151,6 +205,15 @@ func (q *Queries) FindManyParameterizationsBySemesterId(ctx context.Context, sem
 			&i.Numclassesperdiscipline,
 			&i.SemesterID,
 			&i.CourseID,
+			&i.Populationsize,
+			&i.Generations,
+			&i.Tournamentsize,
+			&i.Mutationrate,
+			&i.Hourspercredit,
+			&i.Maxblockhours,
+			&i.Spreadweight,
+			&i.Samedayweight,
+			&i.Idlegapweight,
 		); err != nil {
 			return nil, err
 		}
@@ -166,7 +229,7 @@ func (q *Queries) FindManyParameterizationsBySemesterId(ctx context.Context, sem
 }
 
 const findParameterizationByID = `-- name: FindParameterizationByID :one
-SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
 FROM parameterization p
 WHERE p.uuid = $1
 `
@@ -181,12 +244,50 @@ func (q *Queries) FindParameterizationByID(ctx context.Context, argUuid uuid.UUI
 		&i.Numclassesperdiscipline,
 		&i.SemesterID,
 		&i.CourseID,
+		&i.Populationsize,
+		&i.Generations,
+		&i.Tournamentsize,
+		&i.Mutationrate,
+		&i.Hourspercredit,
+		&i.Maxblockhours,
+		&i.Spreadweight,
+		&i.Samedayweight,
+		&i.Idlegapweight,
+	)
+	return i, err
+}
+
+const findParameterizationByParameterizationId = `-- name: FindParameterizationByParameterizationId :one
+SELECT p.id, p.uuid, p.maxCreditsToOffer, p.numClassesPerDiscipline, p.semester_id, p.course_id, p.populationSize, p.generations, p.tournamentSize, p.mutationRate, p.hoursPerCredit, p.maxBlockHours, p.spreadWeight, p.sameDayWeight, p.idleGapWeight
+FROM parameterization p
+WHERE p.id = $1
+`
+
+func (q *Queries) FindParameterizationByParameterizationId(ctx context.Context, iD int64) (Parameterization, error) {
+	row := q.db.QueryRowContext(ctx, findParameterizationByParameterizationId, iD)
+	var i Parameterization
+	err := row.Scan(
+		&i.ID,
+		&i.Uuid,
+		&i.Maxcreditstooffer,
+		&i.Numclassesperdiscipline,
+		&i.SemesterID,
+		&i.CourseID,
+		&i.Populationsize,
+		&i.Generations,
+		&i.Tournamentsize,
+		&i.Mutationrate,
+		&i.Hourspercredit,
+		&i.Maxblockhours,
+		&i.Spreadweight,
+		&i.Samedayweight,
+		&i.Idlegapweight,
 	)
 	return i, err
 }
 
 const getDisciplinesByCourseID = `-- name: GetDisciplinesByCourseID :many
-SELECT id, uuid, name, credits, course_id FROM discipline WHERE course_id = $1
+SELECT id, uuid, name, credits, course_id, room_type, expected_enrolment, term, code FROM discipline WHERE course_id = $1
 `
 
 func (q *Queries) GetDisciplinesByCourseID(ctx context.Context, courseID int64) ([]Discipline, error) {
@@ -204,6 +305,10 @@ func (q *Queries) GetDisciplinesByCourseID(ctx context.Context, courseID int64)
 			&i.Name,
 			&i.Credits,
 			&i.CourseID,
+			&i.RoomType,
+			&i.ExpectedEnrolment,
+			&i.Term,
+			&i.Code,
 		); err != nil {
 			return nil, err
 		}
@@ -219,7 +324,7 @@ func (q *Queries) GetDisciplinesByCourseID(ctx context.Context, courseID int64)
 }
 
 const getParameterizationByID = `-- name: GetParameterizationByID :one
-SELECT id, uuid, maxcreditstooffer, numclassesperdiscipline, semester_id, course_id from parameterization p where p.uuid = $1
+SELECT id, uuid, maxcreditstooffer, numclassesperdiscipline, semester_id, course_id, populationsize, generations, tournamentsize, mutationrate, hourspercredit, maxblockhours, spreadweight, samedayweight, idlegapweight from parameterization p where p.uuid = $1
 `
 
 func (q *Queries) GetParameterizationByID(ctx context.Context, argUuid uuid.UUID) (Parameterization, error) {
@@ -232,6 +337,15 @@ func (q *Queries) GetParameterizationByID(ctx context.Context, argUuid uuid.UUID
 		&i.Numclassesperdiscipline,
 		&i.SemesterID,
 		&i.CourseID,
+		&i.Populationsize,
+		&i.Generations,
+		&i.Tournamentsize,
+		&i.Mutationrate,
+		&i.Hourspercredit,
+		&i.Maxblockhours,
+		&i.Spreadweight,
+		&i.Samedayweight,
+		&i.Idlegapweight,
 	)
 	return i, err
 }
@@ -286,7 +400,16 @@ func (q *Queries) GetProposalID(ctx context.Context, argUuid uuid.UUID) (int64,
 const updateParameterization = `-- name: UpdateParameterization :exec
 UPDATE parameterization SET
     maxCreditsToOffer = COALESCE($2, maxCreditsToOffer),
-    numClassesPerDiscipline = COALESCE($3, numClassesPerDiscipline)
+    numClassesPerDiscipline = COALESCE($3, numClassesPerDiscipline),
+    populationSize = COALESCE($4, populationSize),
+    generations = COALESCE($5, generations),
+    tournamentSize = COALESCE($6, tournamentSize),
+    mutationRate = COALESCE($7, mutationRate),
+    hoursPerCredit = COALESCE($8, hoursPerCredit),
+    maxBlockHours = COALESCE($9, maxBlockHours),
+    spreadWeight = COALESCE($10, spreadWeight),
+    sameDayWeight = COALESCE($11, sameDayWeight),
+    idleGapWeight = COALESCE($12, idleGapWeight)
 WHERE uuid = $1
 `
 
@@ -294,9 +417,31 @@ type UpdateParameterizationParams struct {
 	Uuid                    uuid.UUID
 	MaxCreditsToOffer       sql.NullInt32
 	NumClassesPerDiscipline sql.NullInt32
+	PopulationSize          sql.NullInt32
+	Generations             sql.NullInt32
+	TournamentSize          sql.NullInt32
+	MutationRate            sql.NullFloat64
+	HoursPerCredit          sql.NullInt32
+	MaxBlockHours           sql.NullInt32
+	SpreadWeight            sql.NullFloat64
+	SameDayWeight           sql.NullFloat64
+	IdleGapWeight           sql.NullFloat64
 }
 
 func (q *Queries) UpdateParameterization(ctx context.Context, arg UpdateParameterizationParams) error {
-	_, err := q.db.ExecContext(ctx, updateParameterization, arg.Uuid, arg.MaxCreditsToOffer, arg.NumClassesPerDiscipline)
+	_, err := q.db.ExecContext(ctx, updateParameterization,
+		arg.Uuid,
+		arg.MaxCreditsToOffer,
+		arg.NumClassesPerDiscipline,
+		arg.PopulationSize,
+		arg.Generations,
+		arg.TournamentSize,
+		arg.MutationRate,
+		arg.HoursPerCredit,
+		arg.MaxBlockHours,
+		arg.SpreadWeight,
+		arg.SameDayWeight,
+		arg.IdleGapWeight,
+	)
 	return err
 }
@@ -122,6 +122,7 @@ FROM
     eligible_disciplines ed ON p.id = ed.professor_id
         LEFT JOIN
     discipline d ON ed.discipline_id = d.id
+ORDER BY p.id, d.id
 `
 
 type GetProfessorsWithDisciplinesRow struct {
@@ -8,22 +8,52 @@ package sqlc
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 const createSemester = `-- name: CreateSemester :exec
-INSERT INTO semester (uuid, semester)
-VALUES ($1, $2)
+INSERT INTO semester (uuid, semester, start_date, end_date)
+VALUES ($1, $2, $3, $4)
 `
 
 type CreateSemesterParams struct {
-	Uuid     uuid.UUID
-	Semester string
+	Uuid      uuid.UUID
+	Semester  string
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
 }
 
 func (q *Queries) CreateSemester(ctx context.Context, arg CreateSemesterParams) error {
-	_, err := q.db.ExecContext(ctx, createSemester, arg.Uuid, arg.Semester)
+	_, err := q.db.ExecContext(ctx, createSemester,
+		arg.Uuid,
+		arg.Semester,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	return err
+}
+
+const createSemesterHoliday = `-- name: CreateSemesterHoliday :exec
+INSERT INTO semester_holiday (uuid, semester_id, date, description)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateSemesterHolidayParams struct {
+	Uuid        uuid.UUID
+	SemesterID  int64
+	Date        time.Time
+	Description string
+}
+
+func (q *Queries) CreateSemesterHoliday(ctx context.Context, arg CreateSemesterHolidayParams) error {
+	_, err := q.db.ExecContext(ctx, createSemesterHoliday,
+		arg.Uuid,
+		arg.SemesterID,
+		arg.Date,
+		arg.Description,
+	)
 	return err
 }
 
@@ -36,8 +66,53 @@ func (q *Queries) DeleteSemester(ctx context.Context, argUuid uuid.UUID) error {
 	return err
 }
 
+const deleteSemesterHoliday = `-- name: DeleteSemesterHoliday :exec
+DELETE FROM semester_holiday WHERE uuid = $1
+`
+
+func (q *Queries) DeleteSemesterHoliday(ctx context.Context, argUuid uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteSemesterHoliday, argUuid)
+	return err
+}
+
+const findManySemesterHolidaysBySemesterId = `-- name: FindManySemesterHolidaysBySemesterId :many
+SELECT sh.id, sh.uuid, sh.semester_id, sh.date, sh.description
+FROM semester_holiday sh
+WHERE sh.semester_id = $1
+ORDER BY sh.date ASC
+`
+
+func (q *Queries) FindManySemesterHolidaysBySemesterId(ctx context.Context, semesterID int64) ([]SemesterHoliday, error) {
+	rows, err := q.db.QueryContext(ctx, findManySemesterHolidaysBySemesterId, semesterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SemesterHoliday
+	for rows.Next() {
+		var i SemesterHoliday
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uuid,
+			&i.SemesterID,
+			&i.Date,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findManySemesters = `-- name: FindManySemesters :many
-SELECT s.id, s.uuid, s.semester
+SELECT s.id, s.uuid, s.semester, s.start_date, s.end_date
 FROM semester s
 ORDER BY s.semester ASC
 `
@@ -51,7 +126,13 @@ func (q *Queries) FindManySemesters(ctx context.Context) ([]Semester, error) {
 	var items []Semester
 	for rows.Next() {
 		var i Semester
-		if err := rows.Scan(&i.ID, &i.Uuid, &i.Semester); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uuid,
+			&i.Semester,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -66,7 +147,7 @@ func (q *Queries) FindManySemesters(ctx context.Context) ([]Semester, error) {
 }
 
 const findSemesterByID = `-- name: FindSemesterByID :one
-SELECT s.id, s.uuid, s.semester
+SELECT s.id, s.uuid, s.semester, s.start_date, s.end_date
 FROM semester s
 WHERE s.uuid = $1
 `
@@ -74,33 +155,134 @@ WHERE s.uuid = $1
 func (q *Queries) FindSemesterByID(ctx context.Context, argUuid uuid.UUID) (Semester, error) {
 	row := q.db.QueryRowContext(ctx, findSemesterByID, argUuid)
 	var i Semester
-	err := row.Scan(&i.ID, &i.Uuid, &i.Semester)
+	err := row.Scan(
+		&i.ID,
+		&i.Uuid,
+		&i.Semester,
+		&i.StartDate,
+		&i.EndDate,
+	)
+	return i, err
+}
+
+const findSemesterBySemesterId = `-- name: FindSemesterBySemesterId :one
+SELECT s.id, s.uuid, s.semester, s.start_date, s.end_date
+FROM semester s
+WHERE s.id = $1
+`
+
+func (q *Queries) FindSemesterBySemesterId(ctx context.Context, id int64) (Semester, error) {
+	row := q.db.QueryRowContext(ctx, findSemesterBySemesterId, id)
+	var i Semester
+	err := row.Scan(
+		&i.ID,
+		&i.Uuid,
+		&i.Semester,
+		&i.StartDate,
+		&i.EndDate,
+	)
+	return i, err
+}
+
+const findSemesterHolidayByDate = `-- name: FindSemesterHolidayByDate :one
+SELECT sh.id, sh.uuid, sh.semester_id, sh.date, sh.description
+FROM semester_holiday sh
+WHERE sh.semester_id = $1 AND sh.date = $2
+`
+
+type FindSemesterHolidayByDateParams struct {
+	SemesterID int64
+	Date       time.Time
+}
+
+func (q *Queries) FindSemesterHolidayByDate(ctx context.Context, arg FindSemesterHolidayByDateParams) (SemesterHoliday, error) {
+	row := q.db.QueryRowContext(ctx, findSemesterHolidayByDate, arg.SemesterID, arg.Date)
+	var i SemesterHoliday
+	err := row.Scan(
+		&i.ID,
+		&i.Uuid,
+		&i.SemesterID,
+		&i.Date,
+		&i.Description,
+	)
+	return i, err
+}
+
+const findSemesterHolidayByID = `-- name: FindSemesterHolidayByID :one
+SELECT sh.id, sh.uuid, sh.semester_id, sh.date, sh.description
+FROM semester_holiday sh
+WHERE sh.uuid = $1
+`
+
+func (q *Queries) FindSemesterHolidayByID(ctx context.Context, argUuid uuid.UUID) (SemesterHoliday, error) {
+	row := q.db.QueryRowContext(ctx, findSemesterHolidayByID, argUuid)
+	var i SemesterHoliday
+	err := row.Scan(
+		&i.ID,
+		&i.Uuid,
+		&i.SemesterID,
+		&i.Date,
+		&i.Description,
+	)
 	return i, err
 }
 
 const getSemesterByID = `-- name: GetSemesterByID :one
-SELECT id, uuid, semester from semester s where s.uuid = $1
+SELECT id, uuid, semester, start_date, end_date from semester s where s.uuid = $1
 `
 
 func (q *Queries) GetSemesterByID(ctx context.Context, argUuid uuid.UUID) (Semester, error) {
 	row := q.db.QueryRowContext(ctx, getSemesterByID, argUuid)
 	var i Semester
-	err := row.Scan(&i.ID, &i.Uuid, &i.Semester)
+	err := row.Scan(
+		&i.ID,
+		&i.Uuid,
+		&i.Semester,
+		&i.StartDate,
+		&i.EndDate,
+	)
 	return i, err
 }
 
 const updateSemester = `-- name: UpdateSemester :exec
 UPDATE semester SET
-    semester = COALESCE($2, semester)
+    semester = COALESCE($2, semester),
+    start_date = COALESCE($3, start_date),
+    end_date = COALESCE($4, end_date)
 WHERE uuid = $1
 `
 
 type UpdateSemesterParams struct {
-	Uuid     uuid.UUID
-	Semester sql.NullString
+	Uuid      uuid.UUID
+	Semester  sql.NullString
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
 }
 
 func (q *Queries) UpdateSemester(ctx context.Context, arg UpdateSemesterParams) error {
-	_, err := q.db.ExecContext(ctx, updateSemester, arg.Uuid, arg.Semester)
+	_, err := q.db.ExecContext(ctx, updateSemester,
+		arg.Uuid,
+		arg.Semester,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	return err
+}
+
+const updateSemesterHoliday = `-- name: UpdateSemesterHoliday :exec
+UPDATE semester_holiday SET
+    date = COALESCE($2, date),
+    description = COALESCE($3, description)
+WHERE uuid = $1
+`
+
+type UpdateSemesterHolidayParams struct {
+	Uuid        uuid.UUID
+	Date        sql.NullTime
+	Description sql.NullString
+}
+
+func (q *Queries) UpdateSemesterHoliday(ctx context.Context, arg UpdateSemesterHolidayParams) error {
+	_, err := q.db.ExecContext(ctx, updateSemesterHoliday, arg.Uuid, arg.Date, arg.Description)
 	return err
 }
@@ -2,11 +2,13 @@ package dto
 
 type CreateAvailabilityDto struct {
 	DayOfWeek   string `json:"dayOfWeek" validate:"required,min=3,max=255"`
-	Shift       string `json:"shift" validate:"required,min=3,max=255"`
-	ProfessorId int64  `json:"professor_id" validate:"required""`
+	StartTime   string `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime     string `json:"end_time" validate:"required,datetime=15:04"`
+	ProfessorId int64  `json:"professor_id" validate:"required"`
 }
 
 type UpdateAvailabilityDto struct {
-	DayOfWeek string `json:"dayOfWeek" validate:"required,min=3,max=255"`
-	Shift     string `json:"shift" validate:"required,min=3,max=255"`
+	DayOfWeek string `json:"dayOfWeek" validate:"omitempty,min=3,max=255"`
+	StartTime string `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndTime   string `json:"end_time" validate:"omitempty,datetime=15:04"`
 }
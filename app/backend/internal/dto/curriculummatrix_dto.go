@@ -0,0 +1,24 @@
+package dto
+
+type CreateCurriculumMatrixDto struct {
+	CourseId int64  `json:"course_id" validate:"required"`
+	Version  string `json:"version" validate:"required,max=20"`
+	Active   bool   `json:"active"`
+}
+
+type UpdateCurriculumMatrixDto struct {
+	Version string `json:"version" validate:"omitempty,max=20"`
+	Active  *bool  `json:"active"`
+}
+
+type CreateCurriculumMatrixDisciplineDto struct {
+	CurriculumMatrixId int64 `json:"curriculum_matrix_id" validate:"required"`
+	DisciplineId       int64 `json:"discipline_id" validate:"required"`
+	Term               int32 `json:"term" validate:"required,min=1,max=20"`
+	Mandatory          *bool `json:"mandatory"`
+}
+
+type UpdateCurriculumMatrixDisciplineDto struct {
+	Term      *int32 `json:"term" validate:"omitempty,min=1,max=20"`
+	Mandatory *bool  `json:"mandatory"`
+}
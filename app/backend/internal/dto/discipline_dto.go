@@ -1,12 +1,20 @@
 package dto
 
 type CreateDisciplineDto struct {
-	Name     string `json:"name" validate:"required,min=3,max=255"`
-	Credits  int32  `json:"credits" validate:"required"`
-	CourseId int64  `json:"course_id" validate:"required"`
+	Code              string `json:"code" validate:"required,max=20"`
+	Name              string `json:"name" validate:"required,min=3,max=255"`
+	Credits           int32  `json:"credits" validate:"required"`
+	CourseId          int64  `json:"course_id" validate:"required"`
+	RoomType          string `json:"room_type" validate:"required,oneof=lecture lab"`
+	ExpectedEnrolment int32  `json:"expected_enrolment" validate:"min=0"`
+	Term              int32  `json:"term" validate:"min=0,max=20"`
 }
 
 type UpdateDisciplineDto struct {
-	Name    string `json:"name" validate:"required,min=3,max=255"`
-	Credits int32  `json:"credits" validate:"required"`
+	Code              string `json:"code" validate:"omitempty,max=20"`
+	Name              string `json:"name" validate:"required,min=3,max=255"`
+	Credits           int32  `json:"credits" validate:"required"`
+	RoomType          string `json:"room_type" validate:"omitempty,oneof=lecture lab"`
+	ExpectedEnrolment int32  `json:"expected_enrolment" validate:"omitempty,min=0"`
+	Term              *int32 `json:"term" validate:"omitempty,min=0,max=20"`
 }
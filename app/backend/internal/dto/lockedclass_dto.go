@@ -0,0 +1,26 @@
+package dto
+
+type CreateLockedClassDto struct {
+	ParameterizationId int64  `json:"parameterization_id" validate:"required"`
+	DisciplineId       int64  `json:"discipline_id" validate:"required"`
+	ProfessorId        int64  `json:"professor_id" validate:"required"`
+	RoomId             int64  `json:"room_id"`
+	Section            int32  `json:"section" validate:"omitempty,min=1"`
+	DayOfWeek          string `json:"day_of_week" validate:"required,oneof=Monday Tuesday Wednesday Thursday Friday Saturday"`
+	StartTime          string `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime            string `json:"end_time" validate:"required,datetime=15:04"`
+}
+
+type UpdateLockedClassDto struct {
+	ProfessorId *int64 `json:"professor_id" validate:"omitempty,min=1"`
+	RoomId      *int64 `json:"room_id" validate:"omitempty,min=0"`
+	Section     *int32 `json:"section" validate:"omitempty,min=1"`
+	DayOfWeek   string `json:"day_of_week" validate:"omitempty,oneof=Monday Tuesday Wednesday Thursday Friday Saturday"`
+	StartTime   string `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndTime     string `json:"end_time" validate:"omitempty,datetime=15:04"`
+}
+
+type LockProposalClassesDto struct {
+	ParameterizationId int64    `json:"parameterization_id" validate:"required"`
+	ClassUuids         []string `json:"class_uuids" validate:"omitempty,unique,dive,uuid4"`
+}
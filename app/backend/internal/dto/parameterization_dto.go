@@ -1,13 +1,31 @@
 package dto
 
 type CreateParameterizationDto struct {
-	MaxCreditsToOffer       int32 `json:"maxCreditsToOffer" validate:"required"`
-	NumClassesPerDiscipline int32 `json:"numClassesPerDiscipline" validate:"required"`
-	SemesterId              int64 `json:"semester_id" validate:"required"`
-	CourseId                int64 `json:"course_id" validate:"required"`
+	MaxCreditsToOffer       int32    `json:"maxCreditsToOffer" validate:"required"`
+	NumClassesPerDiscipline int32    `json:"numClassesPerDiscipline" validate:"required,min=1"`
+	SemesterId              int64    `json:"semester_id" validate:"required"`
+	CourseId                int64    `json:"course_id" validate:"required"`
+	PopulationSize          int32    `json:"populationSize" validate:"omitempty,min=2,max=10000"`
+	Generations             int32    `json:"generations" validate:"omitempty,min=1,max=100000"`
+	TournamentSize          int32    `json:"tournamentSize" validate:"omitempty,min=1,max=10000"`
+	MutationRate            *float64 `json:"mutationRate" validate:"omitempty,gte=0,lte=1"`
+	HoursPerCredit          int32    `json:"hoursPerCredit" validate:"omitempty,min=1,max=10"`
+	MaxBlockHours           int32    `json:"maxBlockHours" validate:"omitempty,min=1,max=4"`
+	SpreadWeight            *float64 `json:"spreadWeight" validate:"omitempty,gte=0,lte=100"`
+	SameDayWeight           *float64 `json:"sameDayWeight" validate:"omitempty,gte=0,lte=100"`
+	IdleGapWeight           *float64 `json:"idleGapWeight" validate:"omitempty,gte=0,lte=100"`
 }
 
 type UpdateParameterizationDto struct {
-	MaxCreditsToOffer       int32 `json:"maxCreditsToOffer" validate:"required"`
-	NumClassesPerDiscipline int32 `json:"numClassesPerDiscipline" validate:"required"`
+	MaxCreditsToOffer       int32    `json:"maxCreditsToOffer" validate:"required"`
+	NumClassesPerDiscipline int32    `json:"numClassesPerDiscipline" validate:"required,min=1"`
+	PopulationSize          int32    `json:"populationSize" validate:"omitempty,min=2,max=10000"`
+	Generations             int32    `json:"generations" validate:"omitempty,min=1,max=100000"`
+	TournamentSize          int32    `json:"tournamentSize" validate:"omitempty,min=1,max=10000"`
+	MutationRate            *float64 `json:"mutationRate" validate:"omitempty,gte=0,lte=1"`
+	HoursPerCredit          int32    `json:"hoursPerCredit" validate:"omitempty,min=1,max=10"`
+	MaxBlockHours           int32    `json:"maxBlockHours" validate:"omitempty,min=1,max=4"`
+	SpreadWeight            *float64 `json:"spreadWeight" validate:"omitempty,gte=0,lte=100"`
+	SameDayWeight           *float64 `json:"sameDayWeight" validate:"omitempty,gte=0,lte=100"`
+	IdleGapWeight           *float64 `json:"idleGapWeight" validate:"omitempty,gte=0,lte=100"`
 }
@@ -0,0 +1,13 @@
+package dto
+
+type CreateParameterizationConstraintDto struct {
+	ParameterizationId int64   `json:"parameterization_id" validate:"required"`
+	Name               string  `json:"name" validate:"required"`
+	Enabled            *bool   `json:"enabled"`
+	Weight             float64 `json:"weight" validate:"omitempty,gt=0,lte=100"`
+}
+
+type UpdateParameterizationConstraintDto struct {
+	Enabled *bool    `json:"enabled"`
+	Weight  *float64 `json:"weight" validate:"omitempty,gte=0,lte=100"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+type CreateParameterizationDisciplineDto struct {
+	ParameterizationId int64 `json:"parameterization_id" validate:"required"`
+	DisciplineId       int64 `json:"discipline_id" validate:"required"`
+	Priority           int32 `json:"priority" validate:"min=0"`
+	Mandatory          bool  `json:"mandatory"`
+}
+
+type UpdateParameterizationDisciplineDto struct {
+	Priority  *int32 `json:"priority" validate:"omitempty,min=0"`
+	Mandatory *bool  `json:"mandatory"`
+}
@@ -4,12 +4,20 @@ import (
 	"time"
 )
 
+type GenerateProposalDto struct {
+	Seed *int64 `json:"seed"`
+}
+
 type DisciplineDTO struct {
-	ID       int64  `json:"id"`
-	UUID     string `json:"uuid"`
-	Name     string `json:"name"`
-	Credits  int    `json:"credits"`
-	CourseID int64  `json:"course_id"`
+	ID                int64  `json:"id"`
+	UUID              string `json:"uuid"`
+	Name              string `json:"name"`
+	Credits           int    `json:"credits"`
+	CourseID          int64  `json:"course_id"`
+	RoomType          string `json:"room_type,omitempty"`
+	ExpectedEnrolment int32  `json:"expected_enrolment,omitempty"`
+	Term              int32  `json:"term,omitempty"`
+	Code              string `json:"code,omitempty"`
 }
 
 type ProfessorDTO struct {
@@ -20,6 +28,15 @@ type ProfessorDTO struct {
 	Disciplines     []DisciplineDTO `json:"disciplines"`
 }
 
+type RoomDTO struct {
+	ID       int64  `json:"id"`
+	UUID     string `json:"uuid"`
+	Name     string `json:"name"`
+	Capacity int32  `json:"capacity"`
+	Type     string `json:"type"`
+	Location string `json:"location"`
+}
+
 type ClassDTO struct {
 	ID         int64         `json:"id"`
 	UUID       string        `json:"uuid"`
@@ -27,15 +44,36 @@ type ClassDTO struct {
 	Shift      string        `json:"shift"`
 	StartTime  time.Time     `json:"start_time"`
 	EndTime    time.Time     `json:"end_time"`
+	Section    int32         `json:"section"`
 	Discipline DisciplineDTO `json:"discipline"`
 	Professor  ProfessorDTO  `json:"professor"`
+	Room       *RoomDTO      `json:"room,omitempty"`
 	ProposalID int64         `json:"proposal_id"`
+	Locked     bool          `json:"locked"`
+}
+
+type ConstraintScoreDTO struct {
+	Name       string  `json:"name"`
+	Hard       bool    `json:"hard"`
+	Weight     float64 `json:"weight"`
+	Violations int     `json:"violations"`
+	Penalty    float64 `json:"penalty"`
 }
 
 type ProposalDTO struct {
-	ID         int64      `json:"id"`
-	UUID       string     `json:"uuid"`
-	SemesterID int64      `json:"semester_id"`
-	CourseID   int64      `json:"course_id"`
-	Classes    []ClassDTO `json:"classes"`
+	ID                 int64                `json:"id"`
+	UUID               string               `json:"uuid"`
+	SemesterID         int64                `json:"semester_id"`
+	CourseID           int64                `json:"course_id"`
+	ParameterizationID int64                `json:"parameterization_id,omitempty"`
+	Fitness            float64              `json:"fitness"`
+	FitnessBreakdown   []ConstraintScoreDTO `json:"fitness_breakdown,omitempty"`
+	Seed               int64                `json:"seed"`
+	PopulationSize     int32                `json:"population_size,omitempty"`
+	Generations        int32                `json:"generations,omitempty"`
+	TournamentSize     int32                `json:"tournament_size,omitempty"`
+	MutationRate       float64              `json:"mutation_rate,omitempty"`
+	CreatedAt          time.Time            `json:"created_at"`
+	Status             string               `json:"status"`
+	Classes            []ClassDTO           `json:"classes,omitempty"`
 }
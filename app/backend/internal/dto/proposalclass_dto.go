@@ -0,0 +1,23 @@
+package dto
+
+type CreateProposalClassDto struct {
+	DisciplineId int64  `json:"discipline_id" validate:"required"`
+	ProfessorId  int64  `json:"professor_id" validate:"required"`
+	RoomId       int64  `json:"room_id"`
+	Section      int32  `json:"section" validate:"omitempty,min=1"`
+	DayOfWeek    string `json:"day_of_week" validate:"required,oneof=Monday Tuesday Wednesday Thursday Friday Saturday"`
+	StartTime    string `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime      string `json:"end_time" validate:"required,datetime=15:04"`
+	Force        bool   `json:"force"`
+	Reason       string `json:"reason" validate:"omitempty,max=1000"`
+}
+
+type UpdateProposalClassDto struct {
+	ProfessorId *int64 `json:"professor_id" validate:"omitempty,min=1"`
+	RoomId      *int64 `json:"room_id" validate:"omitempty,min=0"`
+	DayOfWeek   string `json:"day_of_week" validate:"omitempty,oneof=Monday Tuesday Wednesday Thursday Friday Saturday"`
+	StartTime   string `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndTime     string `json:"end_time" validate:"omitempty,datetime=15:04"`
+	Force       bool   `json:"force"`
+	Reason      string `json:"reason" validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type TransitionProposalDto struct {
+	Status  string `json:"status" validate:"required,oneof=draft under_review approved published archived"`
+	Comment string `json:"comment" validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type RestoreProposalVersionDto struct {
+	Reason string `json:"reason" validate:"omitempty,max=1000"`
+}
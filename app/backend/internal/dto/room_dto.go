@@ -0,0 +1,15 @@
+package dto
+
+type CreateRoomDto struct {
+	Name     string `json:"name" validate:"required,min=1,max=255"`
+	Capacity int32  `json:"capacity" validate:"required,min=1"`
+	Type     string `json:"type" validate:"required,oneof=lecture lab"`
+	Location string `json:"location" validate:"required"`
+}
+
+type UpdateRoomDto struct {
+	Name     string `json:"name" validate:"omitempty,min=1,max=255"`
+	Capacity int32  `json:"capacity" validate:"omitempty,min=1"`
+	Type     string `json:"type" validate:"omitempty,oneof=lecture lab"`
+	Location string `json:"location" validate:"omitempty"`
+}
@@ -1,9 +1,24 @@
 package dto
 
 type CreateSemesterDto struct {
-	Semester string `json:"semester" validate:"required,min=3,max=255"`
+	Semester  string `json:"semester" validate:"required,min=3,max=255"`
+	StartDate string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
 }
 
 type UpdateSemesterDto struct {
-	Semester string `json:"semester" validate:"omitempty,min=3,max=255"`
+	Semester  string `json:"semester" validate:"omitempty,min=3,max=255"`
+	StartDate string `json:"start_date" validate:"omitempty,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type CreateSemesterHolidayDto struct {
+	SemesterId  int64  `json:"semester_id" validate:"required"`
+	Date        string `json:"date" validate:"required,datetime=2006-01-02"`
+	Description string `json:"description" validate:"required,min=1,max=255"`
+}
+
+type UpdateSemesterHolidayDto struct {
+	Date        string `json:"date" validate:"omitempty,datetime=2006-01-02"`
+	Description string `json:"description" validate:"omitempty,min=1,max=255"`
 }
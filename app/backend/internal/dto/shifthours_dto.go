@@ -0,0 +1,14 @@
+package dto
+
+type CreateShiftHoursDto struct {
+	Shift     string `json:"shift" validate:"required,oneof=Morning Afternoon Night"`
+	StartTime string `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime   string `json:"end_time" validate:"required,datetime=15:04"`
+	CourseId  int64  `json:"course_id" validate:"omitempty,min=1"`
+	Location  string `json:"location" validate:"omitempty,max=255"`
+}
+
+type UpdateShiftHoursDto struct {
+	StartTime string `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndTime   string `json:"end_time" validate:"omitempty,datetime=15:04"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+type CreateTimeSlotDto struct {
+	Label     string   `json:"label" validate:"required,min=1,max=50"`
+	Shift     string   `json:"shift" validate:"required,oneof=Morning Afternoon Night"`
+	StartTime string   `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime   string   `json:"end_time" validate:"required,datetime=15:04"`
+	Weekdays  []string `json:"weekdays" validate:"omitempty,unique,dive,oneof=Monday Tuesday Wednesday Thursday Friday Saturday"`
+}
+
+type UpdateTimeSlotDto struct {
+	Label     string   `json:"label" validate:"omitempty,min=1,max=50"`
+	Shift     string   `json:"shift" validate:"omitempty,oneof=Morning Afternoon Night"`
+	StartTime string   `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndTime   string   `json:"end_time" validate:"omitempty,datetime=15:04"`
+	Weekdays  []string `json:"weekdays" validate:"omitempty,unique,dive,oneof=Monday Tuesday Wednesday Thursday Friday Saturday"`
+}
@@ -1,11 +1,17 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
+// AvailabilityEntity is a stretch of a weekday a professor can teach in. StartTime and
+// EndTime only carry the time of day.
 type AvailabilityEntity struct {
 	ID          int64     `json:"id"`
 	UUID        uuid.UUID `json:"uuid"`
 	DayOfWeek   string    `json:"day_of_week"`
-	Shift       string    `json:"shift"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
 	ProfessorID int64     `json:"professor_id"`
 }
@@ -12,7 +12,14 @@ type ClassEntity struct {
 	Shift        string    `json:"shift"`
 	StartTime    time.Time `json:"start_time"`
 	EndTime      time.Time `json:"end_time"`
+	Section      int32     `json:"section"`
 	DisciplineID int64     `json:"discipline_id"`
 	ProfessorID  int64     `json:"professor_id"`
 	ProposalID   int64     `json:"proposal_id"`
+	RoomID       int64     `json:"room_id"`
+	Locked       bool      `json:"locked"`
+
+	Discipline *DisciplineEntity `json:"discipline,omitempty"`
+	Professor  *ProfessorEntity  `json:"professor,omitempty"`
+	Room       *RoomEntity       `json:"room,omitempty"`
 }
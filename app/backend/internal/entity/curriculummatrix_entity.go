@@ -0,0 +1,26 @@
+package entity
+
+import "github.com/google/uuid"
+
+// CurriculumMatrixEntity is a version of the curriculum of a course. Only the active
+// version of a course is used when generating its proposals.
+type CurriculumMatrixEntity struct {
+	ID          int64                              `json:"id"`
+	UUID        uuid.UUID                          `json:"uuid"`
+	CourseID    int64                              `json:"course_id"`
+	Version     string                             `json:"version"`
+	Active      bool                               `json:"active"`
+	Disciplines []CurriculumMatrixDisciplineEntity `json:"disciplines"`
+}
+
+// CurriculumMatrixDisciplineEntity places a discipline in a term of a curriculum matrix,
+// either as a mandatory discipline or as an elective.
+type CurriculumMatrixDisciplineEntity struct {
+	ID                 int64             `json:"id"`
+	UUID               uuid.UUID         `json:"uuid"`
+	CurriculumMatrixID int64             `json:"curriculum_matrix_id"`
+	DisciplineID       int64             `json:"discipline_id"`
+	Term               int32             `json:"term"`
+	Mandatory          bool              `json:"mandatory"`
+	Discipline         *DisciplineEntity `json:"discipline,omitempty"`
+}
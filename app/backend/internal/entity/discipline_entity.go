@@ -3,10 +3,13 @@ package entity
 import "github.com/google/uuid"
 
 type DisciplineEntity struct {
-	ID       int64     `json:"id"`
-	UUID     uuid.UUID `json:"uuid"`
-	Code     string    `json:"code"`
-	Name     string    `json:"name"`
-	Credits  int32     `json:"credits"`
-	CourseID int64     `json:"course_id"`
+	ID                int64     `json:"id"`
+	UUID              uuid.UUID `json:"uuid"`
+	Code              string    `json:"code"`
+	Name              string    `json:"name"`
+	Credits           int32     `json:"credits"`
+	CourseID          int64     `json:"course_id"`
+	RoomType          string    `json:"room_type"`
+	ExpectedEnrolment int32     `json:"expected_enrolment"`
+	Term              int32     `json:"term"`
 }
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// LockedClassEntity is a class placed by hand on a parameterization: every proposal
+// generated from it keeps the class as it is. StartTime and EndTime only carry the time
+// of day.
+type LockedClassEntity struct {
+	ID                 int64     `json:"id"`
+	UUID               uuid.UUID `json:"uuid"`
+	ParameterizationID int64     `json:"parameterization_id"`
+	DisciplineID       int64     `json:"discipline_id"`
+	ProfessorID        int64     `json:"professor_id"`
+	RoomID             int64     `json:"room_id"`
+	Section            int32     `json:"section"`
+	DayOfWeek          string    `json:"day_of_week"`
+	StartTime          time.Time `json:"start_time"`
+	EndTime            time.Time `json:"end_time"`
+}
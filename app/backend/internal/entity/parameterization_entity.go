@@ -1,14 +1,54 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Genetic algorithm hyperparameters used when a parameterization does not set its own.
+const (
+	DefaultPopulationSize int32   = 100
+	DefaultGenerations    int32   = 1000
+	DefaultTournamentSize int32   = 5
+	DefaultMutationRate   float64 = 0.01
+)
+
+// Default rule to turn discipline credits into weekly class hours.
+const (
+	DefaultHoursPerCredit int32 = 1
+	DefaultMaxBlockHours  int32 = 2
+)
+
+// Default weights of the criteria that score how well classes are spread over the week.
+const (
+	DefaultSpreadWeight  float64 = 1
+	DefaultSameDayWeight float64 = 1
+	DefaultIdleGapWeight float64 = 1
+)
 
 type ParameterizationEntity struct {
-	ID                      int64              `json:"id"`
-	UUID                    uuid.UUID          `json:"uuid"`
-	MaxCreditsToOffer       int32              `json:"max_credits_to_offer"`
-	NumClassesPerDiscipline int32              `json:"num_classes_per_discipline"`
-	SemesterID              int64              `json:"semester_id"`
-	CourseID                int64              `json:"course_id"`
-	Disciplines             []DisciplineEntity `json:"disciplines"`
-	Professors              []ProfessorEntity  `json:"professors"`
+	ID                      int64                              `json:"id"`
+	UUID                    uuid.UUID                          `json:"uuid"`
+	MaxCreditsToOffer       int32                              `json:"max_credits_to_offer"`
+	NumClassesPerDiscipline int32                              `json:"num_classes_per_discipline"`
+	SemesterID              int64                              `json:"semester_id"`
+	CourseID                int64                              `json:"course_id"`
+	PopulationSize          int32                              `json:"population_size"`
+	Generations             int32                              `json:"generations"`
+	TournamentSize          int32                              `json:"tournament_size"`
+	MutationRate            float64                            `json:"mutation_rate"`
+	HoursPerCredit          int32                              `json:"hours_per_credit"`
+	MaxBlockHours           int32                              `json:"max_block_hours"`
+	SpreadWeight            float64                            `json:"spread_weight"`
+	SameDayWeight           float64                            `json:"same_day_weight"`
+	IdleGapWeight           float64                            `json:"idle_gap_weight"`
+	Disciplines             []DisciplineEntity                 `json:"disciplines"`
+	Professors              []ProfessorEntity                  `json:"professors"`
+	Rooms                   []RoomEntity                       `json:"rooms"`
+	Constraints             []ParameterizationConstraintEntity `json:"constraints"`
+	Shifts                  []ShiftHoursEntity                 `json:"shifts"`
+	TimeSlots               []TimeSlotEntity                   `json:"time_slots"`
+	SemesterStart           time.Time                          `json:"semester_start"`
+	LockedClasses           []LockedClassEntity                `json:"locked_classes"`
+	Availabilities          []AvailabilityEntity               `json:"availabilities"`
 }
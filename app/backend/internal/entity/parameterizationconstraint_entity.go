@@ -0,0 +1,14 @@
+package entity
+
+import "github.com/google/uuid"
+
+// ParameterizationConstraintEntity enables or disables a registered constraint for a
+// parameterization and sets its weight, 0 keeping the default one.
+type ParameterizationConstraintEntity struct {
+	ID                 int64     `json:"id"`
+	UUID               uuid.UUID `json:"uuid"`
+	ParameterizationID int64     `json:"parameterization_id"`
+	Name               string    `json:"name"`
+	Enabled            bool      `json:"enabled"`
+	Weight             float64   `json:"weight"`
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "github.com/google/uuid"
+
+type ParameterizationDisciplineEntity struct {
+	ID                 int64     `json:"id"`
+	UUID               uuid.UUID `json:"uuid"`
+	ParameterizationID int64     `json:"parameterization_id"`
+	DisciplineID       int64     `json:"discipline_id"`
+	Priority           int32     `json:"priority"`
+	Mandatory          bool      `json:"mandatory"`
+}
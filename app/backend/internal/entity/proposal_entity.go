@@ -1,11 +1,70 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProposalStatusDraft       = "draft"
+	ProposalStatusUnderReview = "under_review"
+	ProposalStatusApproved    = "approved"
+	ProposalStatusPublished   = "published"
+	ProposalStatusArchived    = "archived"
+)
 
 type ProposalEntity struct {
-	ID         int64         `json:"id"`
-	UUID       uuid.UUID     `json:"uuid"`
-	SemesterID int64         `json:"semester_id"`
-	CourseID   int64         `json:"course_id"`
-	Classes    []ClassEntity `json:"classes"`
+	ID                 int64             `json:"id"`
+	UUID               uuid.UUID         `json:"uuid"`
+	SemesterID         int64             `json:"semester_id"`
+	CourseID           int64             `json:"course_id"`
+	Seed               int64             `json:"seed"`
+	Fitness            float64           `json:"fitness"`
+	FitnessBreakdown   []ConstraintScore `json:"fitness_breakdown"`
+	ParameterizationID int64             `json:"parameterization_id"`
+	PopulationSize     int32             `json:"population_size"`
+	Generations        int32             `json:"generations"`
+	TournamentSize     int32             `json:"tournament_size"`
+	MutationRate       float64           `json:"mutation_rate"`
+	CreatedAt          time.Time         `json:"created_at"`
+	Status             string            `json:"status"`
+	Classes            []ClassEntity     `json:"classes"`
+}
+
+type ProposalTransitionEntity struct {
+	ID         int64     `json:"id"`
+	UUID       uuid.UUID `json:"uuid"`
+	ProposalID int64     `json:"proposal_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	UserUUID   uuid.UUID `json:"user_uuid"`
+	UserName   string    `json:"user_name"`
+	Comment    string    `json:"comment"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ProposalVersionEntity is an immutable snapshot of the classes of a proposal.
+type ProposalVersionEntity struct {
+	ID               int64             `json:"id"`
+	UUID             uuid.UUID         `json:"uuid"`
+	ProposalID       int64             `json:"proposal_id"`
+	Version          int32             `json:"version"`
+	UserUUID         uuid.UUID         `json:"user_uuid"`
+	UserName         string            `json:"user_name"`
+	Reason           string            `json:"reason"`
+	Fitness          float64           `json:"fitness"`
+	FitnessBreakdown []ConstraintScore `json:"fitness_breakdown"`
+	CreatedAt        time.Time         `json:"created_at"`
+	Classes          []ClassEntity     `json:"classes"`
+}
+
+type ProposalSummaryEntity struct {
+	ID                int64     `json:"id"`
+	UUID              uuid.UUID `json:"uuid"`
+	SemesterID        int64     `json:"semester_id"`
+	CourseID          int64     `json:"course_id"`
+	CourseName        string    `json:"course_name"`
+	SemesterName      string    `json:"semester_name"`
+	MaxCreditsToOffer int32     `json:"max_credits_to_offer"`
 }
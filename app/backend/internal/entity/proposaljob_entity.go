@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ProposalJobStatusPending   = "pending"
+	ProposalJobStatusRunning   = "running"
+	ProposalJobStatusCompleted = "completed"
+	ProposalJobStatusFailed    = "failed"
+)
+
+type ProposalJobEntity struct {
+	ID                   int64     `json:"id"`
+	UUID                 uuid.UUID `json:"uuid"`
+	Status               string    `json:"status"`
+	Progress             int32     `json:"progress"`
+	Error                string    `json:"error"`
+	CreatedAt            time.Time `json:"created_at"`
+	StartedAt            time.Time `json:"started_at"`
+	FinishedAt           time.Time `json:"finished_at"`
+	Seed                 int64     `json:"seed"`
+	ParameterizationID   int64     `json:"parameterization_id"`
+	ParameterizationUUID uuid.UUID `json:"parameterization_uuid"`
+	ProposalID           int64     `json:"proposal_id"`
+	ProposalUUID         uuid.UUID `json:"proposal_uuid"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "github.com/google/uuid"
+
+// Kinds of room a discipline can require.
+const (
+	RoomTypeLecture = "lecture"
+	RoomTypeLab     = "lab"
+)
+
+type RoomEntity struct {
+	ID       int64     `json:"id"`
+	UUID     uuid.UUID `json:"uuid"`
+	Name     string    `json:"name"`
+	Capacity int32     `json:"capacity"`
+	Type     string    `json:"type"`
+	Location string    `json:"location"`
+}
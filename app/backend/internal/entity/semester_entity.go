@@ -1,9 +1,26 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
+// SemesterEntity is an academic semester. StartDate and EndDate are zero while the
+// calendar of the semester has not been set.
 type SemesterEntity struct {
-	ID       int64     `json:"id"`
-	UUID     uuid.UUID `json:"uuid"`
-	Semester string    `json:"semester"`
+	ID        int64                   `json:"id"`
+	UUID      uuid.UUID               `json:"uuid"`
+	Semester  string                  `json:"semester"`
+	StartDate time.Time               `json:"start_date"`
+	EndDate   time.Time               `json:"end_date"`
+	Holidays  []SemesterHolidayEntity `json:"holidays"`
+}
+
+// SemesterHolidayEntity is a day of the semester without classes.
+type SemesterHolidayEntity struct {
+	ID          int64     `json:"id"`
+	UUID        uuid.UUID `json:"uuid"`
+	SemesterID  int64     `json:"semester_id"`
+	Date        time.Time `json:"date"`
+	Description string    `json:"description"`
 }
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ShiftHoursEntity gives the hours of a shift for a course, for the courses at a location
+// or, with neither set, for the whole institution. StartTime and EndTime only carry the
+// time of day.
+type ShiftHoursEntity struct {
+	ID        int64     `json:"id"`
+	UUID      uuid.UUID `json:"uuid"`
+	Shift     string    `json:"shift"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	CourseID  int64     `json:"course_id"`
+	Location  string    `json:"location"`
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TimeSlotEntity is one of the official class periods of the institution. StartTime and
+// EndTime only carry the time of day; a slot without weekdays applies from Monday to
+// Friday.
+type TimeSlotEntity struct {
+	ID        int64     `json:"id"`
+	UUID      uuid.UUID `json:"uuid"`
+	Label     string    `json:"label"`
+	Shift     string    `json:"shift"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Weekdays  []string  `json:"weekdays"`
+}
@@ -1,6 +1,18 @@
 package entity
 
 type Timetable struct {
-	Classes []ClassEntity
-	Fitness float64
+	Classes   []ClassEntity
+	Fitness   float64
+	Breakdown []ConstraintScore
+}
+
+// ConstraintScore is how a timetable fared on one constraint of the fitness: its
+// violations and the penalty they added, weighted and, for hard constraints, multiplied
+// so they dominate the soft ones.
+type ConstraintScore struct {
+	Name       string  `json:"name"`
+	Hard       bool    `json:"hard"`
+	Weight     float64 `json:"weight"`
+	Violations int     `json:"violations"`
+	Penalty    float64 `json:"penalty"`
 }
@@ -0,0 +1,18 @@
+package entity
+
+import "github.com/google/uuid"
+
+const (
+	WorkloadStatusUnder = "under"
+	WorkloadStatusExact = "exact"
+	WorkloadStatusOver  = "over"
+)
+
+type ProfessorWorkloadEntity struct {
+	ProfessorID    int64     `json:"professor_id"`
+	ProfessorUUID  uuid.UUID `json:"professor_uuid"`
+	Name           string    `json:"name"`
+	PlannedHours   int32     `json:"planned_hours"`
+	AllocatedHours float64   `json:"allocated_hours"`
+	Classes        int64     `json:"classes"`
+}
@@ -32,7 +32,6 @@ func GenerateRandomTimetable(disciplines []entity.DisciplineEntity, professors [
 		slot := availableSlots[rand.Intn(len(availableSlots))]
 		class := entity.ClassEntity{
 			DayOfWeek:    slot.DayOfWeek,
-			Shift:        slot.Shift,
 			StartTime:    time.Now(),                    // Exemplo de horário
 			EndTime:      time.Now().Add(1 * time.Hour), // Exemplo de horário
 			DisciplineID: discipline.ID,
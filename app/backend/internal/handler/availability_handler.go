@@ -62,6 +62,12 @@ func (h *handler) CreateAvailability(w http.ResponseWriter, r *http.Request) {
 			json.NewEncoder(w).Encode(msg)
 			return
 		}
+		if err.Error() == "end time must be after start time" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError("end time must be after start time")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
 		slog.Error(fmt.Sprintf("error to create availability: %v", err), slog.String("package", "handler_availability"))
 		w.WriteHeader(http.StatusBadRequest)
 	}
@@ -133,6 +139,12 @@ func (h *handler) UpdateAvailability(w http.ResponseWriter, r *http.Request) {
 			json.NewEncoder(w).Encode(msg)
 			return
 		}
+		if err.Error() == "end time must be after start time" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError("end time must be after start time")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
 
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(err)
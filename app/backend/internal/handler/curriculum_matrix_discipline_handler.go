@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+)
+
+// Create curriculum matrix discipline
+//
+//	@Summary		Add a discipline to a curriculum matrix
+//	@Description	Endpoint for placing a discipline of the course in a term of a curriculum matrix, as mandatory (the default) or elective
+//	@Tags			curriculum matrix discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateCurriculumMatrixDisciplineDto	true	"Create curriculum matrix discipline dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrix-disciplines [post]
+func (h *handler) CreateCurriculumMatrixDiscipline(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateCurriculumMatrixDisciplineDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.curriculumMatrixService.CreateCurriculumMatrixDiscipline(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create curriculum matrix discipline: %v", err), slog.String("package", "handler_curriculum_matrix_discipline"))
+		if err.Error() == "discipline does not belong to the curriculum matrix course" || err.Error() == "discipline already in this curriculum matrix" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create curriculum matrix discipline")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update curriculum matrix discipline
+//
+//	@Summary		Update curriculum matrix discipline
+//	@Description	Endpoint for moving a discipline to another term of the curriculum matrix or changing whether it is mandatory
+//	@Tags			curriculum matrix discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string									true	"curriculum matrix discipline uuid"
+//	@Param			body	body	dto.UpdateCurriculumMatrixDisciplineDto	false	"Update curriculum matrix discipline dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrix-disciplines/{uuid} [patch]
+func (h *handler) UpdateCurriculumMatrixDiscipline(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateCurriculumMatrixDisciplineDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("curriculum matrix discipline id is required", slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("curriculum matrix discipline id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse curriculum matrix discipline id: %v", err), slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid curriculum matrix discipline id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.curriculumMatrixService.UpdateCurriculumMatrixDiscipline(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update curriculum matrix discipline: %v", err), slog.String("package", "handler_curriculum_matrix_discipline"))
+		if err.Error() == "curriculum matrix discipline not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("curriculum matrix discipline not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update curriculum matrix discipline")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Delete curriculum matrix discipline
+//
+//	@Summary		Delete curriculum matrix discipline
+//	@Description	Remove a discipline from a curriculum matrix
+//	@Tags			curriculum matrix discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"curriculum matrix discipline uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrix-disciplines/{uuid} [delete]
+func (h *handler) DeleteCurriculumMatrixDiscipline(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_curriculum_matrix_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.curriculumMatrixService.DeleteCurriculumMatrixDiscipline(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete curriculum matrix discipline: %v", err), slog.String("package", "handler_curriculum_matrix_discipline"))
+		if err.Error() == "curriculum matrix discipline not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("curriculum matrix discipline not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete curriculum matrix discipline")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,374 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"github.com/robinsonvs/time-table-project/internal/service/curriculummatrixservice"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Create curriculum matrix
+//
+//	@Summary		Create new curriculum matrix
+//	@Description	Endpoint for creating a curriculum matrix version of a course; creating it active deactivates the version active so far
+//	@Tags			curriculum matrix
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateCurriculumMatrixDto	true	"Create curriculum matrix dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrices [post]
+func (h *handler) CreateCurriculumMatrix(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateCurriculumMatrixDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.curriculumMatrixService.CreateCurriculumMatrix(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create curriculum matrix: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		if err.Error() == "curriculum matrix version already exists" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create curriculum matrix")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update curriculum matrix
+//
+//	@Summary		Update curriculum matrix
+//	@Description	Endpoint for renaming a curriculum matrix version or (de)activating it; activating it deactivates the other versions of the course
+//	@Tags			curriculum matrix
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string									true	"curriculum matrix uuid"
+//	@Param			body	body	dto.UpdateCurriculumMatrixDto	false	"Update curriculum matrix dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrices/{uuid} [patch]
+func (h *handler) UpdateCurriculumMatrix(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateCurriculumMatrixDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("curriculum matrix id is required", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("curriculum matrix id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse curriculum matrix id: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid curriculum matrix id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.curriculumMatrixService.UpdateCurriculumMatrix(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update curriculum matrix: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		if err.Error() == "curriculum matrix not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("curriculum matrix not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "curriculum matrix version already exists" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update curriculum matrix")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Parameterization constraint details
+//
+//	@Summary		Curriculum matrix details
+//	@Description	Get curriculum matrix by uuid, with its disciplines by term
+//	@Tags			curriculum matrix
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"curriculum matrix uuid"
+//	@Success		200	{object}	response.CurriculumMatrixResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrices/{uuid} [get]
+func (h *handler) GetCurriculumMatrixByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.curriculumMatrixService.GetCurriculumMatrixByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get curriculum matrix: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		if err.Error() == "curriculum matrix not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("curriculum matrix not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get curriculum matrix")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Delete curriculum matrix
+//
+//	@Summary		Delete curriculum matrix
+//	@Description	Delete curriculum matrix by uuid, with its disciplines
+//	@Tags			curriculum matrix
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"curriculum matrix uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrices/{uuid} [delete]
+func (h *handler) DeleteCurriculumMatrix(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.curriculumMatrixService.DeleteCurriculumMatrix(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete curriculum matrix: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		if err.Error() == "curriculum matrix not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("curriculum matrix not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete curriculum matrix")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Get many curriculum matrices by course
+//
+//	@Summary		Get many curriculum matrices by course
+//	@Description	List the curriculum matrix versions of a course
+//	@Tags			curriculum matrix
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			courseId	path	string	true	"course id"
+//	@Success		200	{object}	response.ManyCurriculumMatricesResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrices/list-all/{courseId} [get]
+func (h *handler) FindManyCurriculumMatricesByCourseId(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "courseId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	courseId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.curriculumMatrixService.FindManyCurriculumMatricesByCourseId(r.Context(), courseId)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many curriculum matrices: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many curriculum matrices")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Import curriculum matrix
+//
+//	@Summary		Import the disciplines of a curriculum matrix
+//	@Description	Replace the disciplines of a curriculum matrix with the ones listed in a CSV file, sent as the "file" form field or as the request body. The first line names the columns: code and term are required, mandatory is optional and defaults to true. Nothing is changed unless every line is valid.
+//	@Tags			curriculum matrix
+//	@Security		ApiKeyAuth
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			uuid	path		string	true	"curriculum matrix uuid"
+//	@Param			file	formData	file	true	"CSV file with the code, term and mandatory columns"
+//	@Success		200	{object}	response.CurriculumMatrixResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/curriculum-matrices/{uuid}/import [post]
+func (h *handler) ImportCurriculumMatrix(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_curriculum_matrix"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	var file io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		formFile, _, err := r.FormFile("file")
+		if err != nil {
+			slog.Error(fmt.Sprintf("error to read file: %v", err), slog.String("package", "handler_curriculum_matrix"))
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError("file is required")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		defer formFile.Close()
+		file = formFile
+	}
+
+	res, err := h.curriculumMatrixService.ImportCurriculumMatrix(r.Context(), uuid, file)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to import curriculum matrix: %v", err), slog.String("package", "handler_curriculum_matrix"))
+		if err.Error() == "curriculum matrix not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("curriculum matrix not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if strings.HasPrefix(err.Error(), curriculummatrixservice.ImportInvalidFile) {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to import curriculum matrix")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
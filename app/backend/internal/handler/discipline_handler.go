@@ -56,6 +56,12 @@ func (h *handler) CreateDiscipline(w http.ResponseWriter, r *http.Request) {
 
 	err = h.disciplineService.CreateDiscipline(r.Context(), req)
 	if err != nil {
+		if err.Error() == "discipline code already exists" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
 		if err.Error() == "discipline not found" {
 			w.WriteHeader(http.StatusNotFound)
 			msg := httperr.NewNotFoundError("discipline not found")
@@ -133,6 +139,12 @@ func (h *handler) UpdateDiscipline(w http.ResponseWriter, r *http.Request) {
 			json.NewEncoder(w).Encode(msg)
 			return
 		}
+		if err.Error() == "discipline code already exists" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
 
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(err)
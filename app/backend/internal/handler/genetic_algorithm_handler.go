@@ -5,22 +5,26 @@ import (
 	"fmt"
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
 	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
 	"log/slog"
 	"net/http"
+	"strconv"
 )
 
 // Generate a proposal
 //
 //	@Summary		Generate new proposal
-//	@Description	Endpoint for generate proposal
+//	@Description	Enqueue a job that generates a proposal, poll the returned job for its status
 //	@Tags			proposal
 //	@Security		ApiKeyAuth
 //	@Accept			json
 //	@Produce		json
 //	@Param			parameterizationID	path	string	true	"parameterization uuid"
-//	@Success		200
+//	@Param			body	body	dto.GenerateProposalDto	false	"Generate proposal dto, the seed is optional"
+//	@Success		202	{object}	response.ProposalJobResponse
 //	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
 //	@Failure		500	{object}	httperr.RestErr
 //	@Router			/generate-proposal/{parameterizationID} [post]
 func (h *handler) GenerateProposal(w http.ResponseWriter, r *http.Request) {
@@ -42,10 +46,153 @@ func (h *handler) GenerateProposal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.geneticAlgorithmService.GenerateProposal(r.Context(), uuid)
+	var req dto.GenerateProposalDto
+	if r.Body != http.NoBody {
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			slog.Error("error to decode body", "err", err, slog.String("package", "handler_genetic"))
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError("error to decode body")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+	}
+
+	res, err := h.geneticAlgorithmService.GenerateProposal(r.Context(), uuid, req)
 	if err != nil {
 		slog.Error(fmt.Sprintf("error to generate a proposal: %v", err), slog.String("package", "handler_genetic"))
+		if err.Error() == "parameterization not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("parameterization not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to generate a proposal")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Proposal job details
+//
+//	@Summary		Proposal job details
+//	@Description	Get the status, progress and resulting proposal of a generation job
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"proposal job uuid"
+//	@Success		200	{object}	response.ProposalJobResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposal-jobs/{uuid} [get]
+func (h *handler) GetProposalJobByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_genetic"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_genetic"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.geneticAlgorithmService.GetProposalJobByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get proposal job: %v", err), slog.String("package", "handler_genetic"))
+		if err.Error() == "proposal job not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal job not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewBadRequestError("error to get proposal job")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Get many proposal jobs
+//
+//	@Summary		Get many proposal jobs
+//	@Description	Get many proposal jobs
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.ManyProposalJobsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposal-jobs/list-all [get]
+func (h *handler) FindManyProposalJobs(w http.ResponseWriter, r *http.Request) {
+	res, err := h.geneticAlgorithmService.FindManyProposalJobs(r.Context())
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many proposal jobs: %v", err), slog.String("package", "handler_genetic"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewBadRequestError("error to find many proposal jobs")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Get many proposal jobs by parameterization
+//
+//	@Summary		Get many proposal jobs by parameterization
+//	@Description	Get many proposal jobs by parameterization
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			parameterizationId	path	string	true	"parameterization id"
+//	@Success		200	{object}	response.ManyProposalJobsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposal-jobs/list-all/{parameterizationId} [get]
+func (h *handler) FindManyProposalJobsByParameterizationId(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "parameterizationId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_genetic"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	parameterizationId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_genetic"))
 		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.geneticAlgorithmService.FindManyProposalJobsByParameterizationId(r.Context(), parameterizationId)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many proposal jobs: %v", err), slog.String("package", "handler_genetic"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewBadRequestError("error to find many proposal jobs")
+		json.NewEncoder(w).Encode(msg)
+		return
 	}
-	w.WriteHeader(http.StatusCreated)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
 }
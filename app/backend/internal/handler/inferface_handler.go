@@ -4,11 +4,19 @@ import (
 	"github.com/robinsonvs/time-table-project/internal/core/service"
 	"github.com/robinsonvs/time-table-project/internal/service/availabilityservice"
 	"github.com/robinsonvs/time-table-project/internal/service/courseservice"
+	"github.com/robinsonvs/time-table-project/internal/service/curriculummatrixservice"
 	"github.com/robinsonvs/time-table-project/internal/service/disciplineservice"
 	"github.com/robinsonvs/time-table-project/internal/service/eligibledisciplineservice"
+	"github.com/robinsonvs/time-table-project/internal/service/lockedclassservice"
+	"github.com/robinsonvs/time-table-project/internal/service/parameterizationconstraintservice"
+	"github.com/robinsonvs/time-table-project/internal/service/parameterizationdisciplineservice"
 	"github.com/robinsonvs/time-table-project/internal/service/parameterizationservice"
 	"github.com/robinsonvs/time-table-project/internal/service/professorservice"
+	"github.com/robinsonvs/time-table-project/internal/service/proposalservice"
+	"github.com/robinsonvs/time-table-project/internal/service/roomservice"
 	"github.com/robinsonvs/time-table-project/internal/service/semesterservice"
+	"github.com/robinsonvs/time-table-project/internal/service/shifthoursservice"
+	"github.com/robinsonvs/time-table-project/internal/service/timeslotservice"
 	"github.com/robinsonvs/time-table-project/internal/service/userservice"
 	"net/http"
 )
@@ -21,30 +29,54 @@ func NewHandler(userService userservice.UserService,
 	availabilityService availabilityservice.AvailabilityService,
 	parameterizationService parameterizationservice.ParameterizationService,
 	eligibleDisciplineService eligibledisciplineservice.EligibleDisciplineService,
-	geneticAlgorithmService service.GeneticAlgorithmServiceInterface) Handler {
+	geneticAlgorithmService service.GeneticAlgorithmServiceInterface,
+	proposalService proposalservice.ProposalService,
+	parameterizationDisciplineService parameterizationdisciplineservice.ParameterizationDisciplineService,
+	roomService roomservice.RoomService,
+	parameterizationConstraintService parameterizationconstraintservice.ParameterizationConstraintService,
+	curriculumMatrixService curriculummatrixservice.CurriculumMatrixService,
+	shiftHoursService shifthoursservice.ShiftHoursService,
+	timeSlotService timeslotservice.TimeSlotService,
+	lockedClassService lockedclassservice.LockedClassService) Handler {
 	return &handler{
-		userService:               userService,
-		courseService:             courseService,
-		semesterService:           semesterService,
-		professorService:          professorService,
-		disciplineService:         disciplineService,
-		availabilityService:       availabilityService,
-		parameterizationService:   parameterizationService,
-		eligibleDisciplineService: eligibleDisciplineService,
-		geneticAlgorithmService:   geneticAlgorithmService,
+		userService:                       userService,
+		courseService:                     courseService,
+		semesterService:                   semesterService,
+		professorService:                  professorService,
+		disciplineService:                 disciplineService,
+		availabilityService:               availabilityService,
+		parameterizationService:           parameterizationService,
+		eligibleDisciplineService:         eligibleDisciplineService,
+		geneticAlgorithmService:           geneticAlgorithmService,
+		proposalService:                   proposalService,
+		parameterizationDisciplineService: parameterizationDisciplineService,
+		roomService:                       roomService,
+		parameterizationConstraintService: parameterizationConstraintService,
+		curriculumMatrixService:           curriculumMatrixService,
+		shiftHoursService:                 shiftHoursService,
+		timeSlotService:                   timeSlotService,
+		lockedClassService:                lockedClassService,
 	}
 }
 
 type handler struct {
-	userService               userservice.UserService
-	courseService             courseservice.CourseService
-	semesterService           semesterservice.SemesterService
-	professorService          professorservice.ProfessorService
-	disciplineService         disciplineservice.DisciplineService
-	availabilityService       availabilityservice.AvailabilityService
-	parameterizationService   parameterizationservice.ParameterizationService
-	eligibleDisciplineService eligibledisciplineservice.EligibleDisciplineService
-	geneticAlgorithmService   service.GeneticAlgorithmServiceInterface
+	userService                       userservice.UserService
+	courseService                     courseservice.CourseService
+	semesterService                   semesterservice.SemesterService
+	professorService                  professorservice.ProfessorService
+	disciplineService                 disciplineservice.DisciplineService
+	availabilityService               availabilityservice.AvailabilityService
+	parameterizationService           parameterizationservice.ParameterizationService
+	eligibleDisciplineService         eligibledisciplineservice.EligibleDisciplineService
+	geneticAlgorithmService           service.GeneticAlgorithmServiceInterface
+	proposalService                   proposalservice.ProposalService
+	parameterizationDisciplineService parameterizationdisciplineservice.ParameterizationDisciplineService
+	roomService                       roomservice.RoomService
+	parameterizationConstraintService parameterizationconstraintservice.ParameterizationConstraintService
+	curriculumMatrixService           curriculummatrixservice.CurriculumMatrixService
+	shiftHoursService                 shifthoursservice.ShiftHoursService
+	timeSlotService                   timeslotservice.TimeSlotService
+	lockedClassService                lockedclassservice.LockedClassService
 }
 
 type Handler interface {
@@ -69,6 +101,10 @@ type Handler interface {
 	GetSemesterByID(w http.ResponseWriter, r *http.Request)
 	FindManySemesters(w http.ResponseWriter, r *http.Request)
 
+	CreateSemesterHoliday(w http.ResponseWriter, r *http.Request)
+	UpdateSemesterHoliday(w http.ResponseWriter, r *http.Request)
+	DeleteSemesterHoliday(w http.ResponseWriter, r *http.Request)
+
 	CreateProfessor(w http.ResponseWriter, r *http.Request)
 	UpdateProfessor(w http.ResponseWriter, r *http.Request)
 	DeleteProfessor(w http.ResponseWriter, r *http.Request)
@@ -82,6 +118,12 @@ type Handler interface {
 	FindManyDisciplines(w http.ResponseWriter, r *http.Request)
 	FindManyDisciplinesByCourseId(w http.ResponseWriter, r *http.Request)
 
+	CreateRoom(w http.ResponseWriter, r *http.Request)
+	UpdateRoom(w http.ResponseWriter, r *http.Request)
+	DeleteRoom(w http.ResponseWriter, r *http.Request)
+	GetRoomByID(w http.ResponseWriter, r *http.Request)
+	FindManyRooms(w http.ResponseWriter, r *http.Request)
+
 	CreateAvailability(w http.ResponseWriter, r *http.Request)
 	UpdateAvailability(w http.ResponseWriter, r *http.Request)
 	DeleteAvailability(w http.ResponseWriter, r *http.Request)
@@ -96,8 +138,77 @@ type Handler interface {
 	FindManyParameterizations(w http.ResponseWriter, r *http.Request)
 	FindManyParameterizationsBySemesterId(w http.ResponseWriter, r *http.Request)
 
+	CreateParameterizationDiscipline(w http.ResponseWriter, r *http.Request)
+	UpdateParameterizationDiscipline(w http.ResponseWriter, r *http.Request)
+	DeleteParameterizationDiscipline(w http.ResponseWriter, r *http.Request)
+	GetParameterizationDisciplineByID(w http.ResponseWriter, r *http.Request)
+	FindManyParameterizationDisciplinesByParameterizationId(w http.ResponseWriter, r *http.Request)
+
+	CreateParameterizationConstraint(w http.ResponseWriter, r *http.Request)
+	UpdateParameterizationConstraint(w http.ResponseWriter, r *http.Request)
+	DeleteParameterizationConstraint(w http.ResponseWriter, r *http.Request)
+	GetParameterizationConstraintByID(w http.ResponseWriter, r *http.Request)
+	FindManyParameterizationConstraintsByParameterizationId(w http.ResponseWriter, r *http.Request)
+	FindManyConstraints(w http.ResponseWriter, r *http.Request)
+
+	CreateCurriculumMatrix(w http.ResponseWriter, r *http.Request)
+	UpdateCurriculumMatrix(w http.ResponseWriter, r *http.Request)
+	DeleteCurriculumMatrix(w http.ResponseWriter, r *http.Request)
+	GetCurriculumMatrixByID(w http.ResponseWriter, r *http.Request)
+	FindManyCurriculumMatricesByCourseId(w http.ResponseWriter, r *http.Request)
+	ImportCurriculumMatrix(w http.ResponseWriter, r *http.Request)
+	CreateCurriculumMatrixDiscipline(w http.ResponseWriter, r *http.Request)
+	UpdateCurriculumMatrixDiscipline(w http.ResponseWriter, r *http.Request)
+	DeleteCurriculumMatrixDiscipline(w http.ResponseWriter, r *http.Request)
+
+	CreateShiftHours(w http.ResponseWriter, r *http.Request)
+	UpdateShiftHours(w http.ResponseWriter, r *http.Request)
+	DeleteShiftHours(w http.ResponseWriter, r *http.Request)
+	GetShiftHoursByID(w http.ResponseWriter, r *http.Request)
+	FindManyShiftHours(w http.ResponseWriter, r *http.Request)
+	FindShiftHoursForCourse(w http.ResponseWriter, r *http.Request)
+
+	CreateTimeSlot(w http.ResponseWriter, r *http.Request)
+	UpdateTimeSlot(w http.ResponseWriter, r *http.Request)
+	DeleteTimeSlot(w http.ResponseWriter, r *http.Request)
+	GetTimeSlotByID(w http.ResponseWriter, r *http.Request)
+	FindManyTimeSlots(w http.ResponseWriter, r *http.Request)
+
+	CreateLockedClass(w http.ResponseWriter, r *http.Request)
+	LockProposalClasses(w http.ResponseWriter, r *http.Request)
+	UpdateLockedClass(w http.ResponseWriter, r *http.Request)
+	DeleteLockedClass(w http.ResponseWriter, r *http.Request)
+	GetLockedClassByID(w http.ResponseWriter, r *http.Request)
+	FindManyLockedClassesByParameterizationId(w http.ResponseWriter, r *http.Request)
+
 	CreateEligibleDiscipline(w http.ResponseWriter, r *http.Request)
 	DeleteEligibleDiscipline(w http.ResponseWriter, r *http.Request)
 
 	GenerateProposal(w http.ResponseWriter, r *http.Request)
+	GetProposalJobByID(w http.ResponseWriter, r *http.Request)
+	FindManyProposalJobs(w http.ResponseWriter, r *http.Request)
+	FindManyProposalJobsByParameterizationId(w http.ResponseWriter, r *http.Request)
+
+	GetProposalByID(w http.ResponseWriter, r *http.Request)
+	FindManyProposals(w http.ResponseWriter, r *http.Request)
+	ExportProposal(w http.ResponseWriter, r *http.Request)
+	ExportSemesterOffering(w http.ResponseWriter, r *http.Request)
+	GetProposalWorkload(w http.ResponseWriter, r *http.Request)
+	GetSemesterWorkload(w http.ResponseWriter, r *http.Request)
+	GetProposalMeetings(w http.ResponseWriter, r *http.Request)
+	CompareProposals(w http.ResponseWriter, r *http.Request)
+	TransitionProposal(w http.ResponseWriter, r *http.Request)
+	FindManyProposalTransitions(w http.ResponseWriter, r *http.Request)
+	CreateProposalClass(w http.ResponseWriter, r *http.Request)
+	UpdateProposalClass(w http.ResponseWriter, r *http.Request)
+	DeleteProposalClass(w http.ResponseWriter, r *http.Request)
+	FindManyProposalVersions(w http.ResponseWriter, r *http.Request)
+	GetProposalVersion(w http.ResponseWriter, r *http.Request)
+	CompareProposalVersions(w http.ResponseWriter, r *http.Request)
+	RestoreProposalVersion(w http.ResponseWriter, r *http.Request)
+	GetProfessorTimetable(w http.ResponseWriter, r *http.Request)
+	ExportProfessorTimetable(w http.ResponseWriter, r *http.Request)
+	PrintProfessorTimetable(w http.ResponseWriter, r *http.Request)
+	ExportProposalCalendar(w http.ResponseWriter, r *http.Request)
+	ExportProfessorCalendar(w http.ResponseWriter, r *http.Request)
 }
@@ -0,0 +1,373 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Create locked class
+//
+//	@Summary		Lock a class in a parameterization
+//	@Description	Endpoint for placing a class by hand (day, HH:MM start and end, professor and room); every proposal generated from the parameterization keeps it
+//	@Tags			locked class
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateLockedClassDto	true	"Create locked class dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/locked-classes [post]
+func (h *handler) CreateLockedClass(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateLockedClassDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.lockedClassService.CreateLockedClass(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create locked class: %v", err), slog.String("package", "handler_locked_class"))
+		if err.Error() == "discipline does not belong to the parameterization course" || err.Error() == "end time must be after start time" ||
+			err.Error() == "locked classes of a section must have the same professor" || err.Error() == "locked class overlaps another one" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create locked class")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Lock classes of a proposal
+//
+//	@Summary		Lock classes of a proposal
+//	@Description	Endpoint for locking classes of an existing proposal in a parameterization: the ones listed in class_uuids or, when none is listed, all of them
+//	@Tags			locked class
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string						true	"proposal uuid"
+//	@Param			body	body	dto.LockProposalClassesDto	true	"Lock proposal classes dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/locked-classes/proposal/{uuid} [post]
+func (h *handler) LockProposalClasses(w http.ResponseWriter, r *http.Request) {
+	var req dto.LockProposalClassesDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("proposal id is required", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("proposal id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse proposal id: %v", err), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid proposal id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.lockedClassService.LockProposalClasses(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to lock proposal classes: %v", err), slog.String("package", "handler_locked_class"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "class not found in proposal" || err.Error() == "discipline does not belong to the parameterization course" ||
+			err.Error() == "locked classes of a section must have the same professor" || err.Error() == "locked class overlaps another one" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to lock proposal classes")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update locked class
+//
+//	@Summary		Update locked class
+//	@Description	Endpoint for moving a locked class or changing its professor, room or section
+//	@Tags			locked class
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string									true	"locked class uuid"
+//	@Param			body	body	dto.UpdateLockedClassDto	false	"Update locked class dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/locked-classes/{uuid} [patch]
+func (h *handler) UpdateLockedClass(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateLockedClassDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("locked class id is required", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("locked class id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse locked class id: %v", err), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid locked class id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.lockedClassService.UpdateLockedClass(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update locked class: %v", err), slog.String("package", "handler_locked_class"))
+		if err.Error() == "locked class not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("locked class not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "end time must be after start time" || err.Error() == "locked classes of a section must have the same professor" || err.Error() == "locked class overlaps another one" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update locked class")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Locked class details
+//
+//	@Summary		Locked class details
+//	@Description	Get locked class by uuid
+//	@Tags			locked class
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"locked class uuid"
+//	@Success		200	{object}	response.LockedClassResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/locked-classes/{uuid} [get]
+func (h *handler) GetLockedClassByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.lockedClassService.GetLockedClassByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get locked class: %v", err), slog.String("package", "handler_locked_class"))
+		if err.Error() == "locked class not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("locked class not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get locked class")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Delete locked class
+//
+//	@Summary		Delete locked class
+//	@Description	Unlock a class, leaving its discipline to the generation
+//	@Tags			locked class
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"locked class uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/locked-classes/{uuid} [delete]
+func (h *handler) DeleteLockedClass(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.lockedClassService.DeleteLockedClass(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete locked class: %v", err), slog.String("package", "handler_locked_class"))
+		if err.Error() == "locked class not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("locked class not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete locked class")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Get many locked classes by parameterization
+//
+//	@Summary		Get many locked classes by parameterization
+//	@Description	List the classes locked in a parameterization
+//	@Tags			locked class
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			parameterizationId	path	string	true	"parameterization id"
+//	@Success		200	{object}	response.ManyLockedClassesResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/locked-classes/list-all/{parameterizationId} [get]
+func (h *handler) FindManyLockedClassesByParameterizationId(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "parameterizationId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	parameterizationId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.lockedClassService.FindManyLockedClassesByParameterizationId(r.Context(), parameterizationId)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many locked classes: %v", err), slog.String("package", "handler_locked_class"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many locked classes")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Create parameterization constraint
+//
+//	@Summary		Configure a constraint in a parameterization
+//	@Description	Endpoint for enabling or disabling a registered constraint in a parameterization and setting its weight
+//	@Tags			parameterization constraint
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateParameterizationConstraintDto	true	"Create parameterization constraint dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-constraints [post]
+func (h *handler) CreateParameterizationConstraint(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateParameterizationConstraintDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.parameterizationConstraintService.CreateParameterizationConstraint(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create parameterization constraint: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		if err.Error() == "constraint not registered" || err.Error() == "constraint already configured in this parameterization" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create parameterization constraint")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update parameterization constraint
+//
+//	@Summary		Update parameterization constraint
+//	@Description	Endpoint for enabling or disabling a configured constraint or changing its weight, 0 going back to the default weight
+//	@Tags			parameterization constraint
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string									true	"parameterization constraint uuid"
+//	@Param			body	body	dto.UpdateParameterizationConstraintDto	false	"Update parameterization constraint dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-constraints/{uuid} [patch]
+func (h *handler) UpdateParameterizationConstraint(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateParameterizationConstraintDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("parameterization constraint id is required", slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("parameterization constraint id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse parameterization constraint id: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid parameterization constraint id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.parameterizationConstraintService.UpdateParameterizationConstraint(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update parameterization constraint: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		if err.Error() == "parameterization constraint not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("parameterization constraint not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update parameterization constraint")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Parameterization constraint details
+//
+//	@Summary		Parameterization constraint details
+//	@Description	Get parameterization constraint by uuid
+//	@Tags			parameterization constraint
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"parameterization constraint uuid"
+//	@Success		200	{object}	response.ParameterizationConstraintResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-constraints/{uuid} [get]
+func (h *handler) GetParameterizationConstraintByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.parameterizationConstraintService.GetParameterizationConstraintByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get parameterization constraint: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		if err.Error() == "parameterization constraint not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("parameterization constraint not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get parameterization constraint")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Delete parameterization constraint
+//
+//	@Summary		Delete parameterization constraint
+//	@Description	Remove the configuration of a constraint, which goes back to enabled with its default weight
+//	@Tags			parameterization constraint
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"parameterization constraint uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-constraints/{uuid} [delete]
+func (h *handler) DeleteParameterizationConstraint(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.parameterizationConstraintService.DeleteParameterizationConstraint(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete parameterization constraint: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		if err.Error() == "parameterization constraint not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("parameterization constraint not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete parameterization constraint")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Get many parameterization constraints by parameterization
+//
+//	@Summary		Get many parameterization constraints by parameterization
+//	@Description	List the constraints a parameterization configures
+//	@Tags			parameterization constraint
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			parameterizationId	path	string	true	"parameterization id"
+//	@Success		200	{object}	response.ManyParameterizationConstraintsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-constraints/list-all/{parameterizationId} [get]
+func (h *handler) FindManyParameterizationConstraintsByParameterizationId(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "parameterizationId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	parameterizationId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.parameterizationConstraintService.FindManyParameterizationConstraintsByParameterizationId(r.Context(), parameterizationId)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many parameterization constraints: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many parameterization constraints")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Get many constraints
+//
+//	@Summary		Get many constraints
+//	@Description	List the constraints timetables can be scored with, whether they are hard and their default weight
+//	@Tags			parameterization constraint
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.ManyConstraintsResponse
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-constraints/available [get]
+func (h *handler) FindManyConstraints(w http.ResponseWriter, r *http.Request) {
+	res, err := h.parameterizationConstraintService.FindManyConstraints(r.Context())
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many constraints: %v", err), slog.String("package", "handler_parameterization_constraint"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many constraints")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
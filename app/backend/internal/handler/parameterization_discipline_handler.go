@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Create parameterization discipline
+//
+//	@Summary		Offer a discipline in a parameterization
+//	@Description	Endpoint for choosing a discipline the parameterization should offer, with its priority and mandatory flag
+//	@Tags			parameterization discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateParameterizationDisciplineDto	true	"Create parameterization discipline dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-disciplines [post]
+func (h *handler) CreateParameterizationDiscipline(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateParameterizationDisciplineDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.parameterizationDisciplineService.CreateParameterizationDiscipline(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create parameterization discipline: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		if err.Error() == "discipline does not belong to the parameterization course" || err.Error() == "discipline already offered in this parameterization" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create parameterization discipline")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update parameterization discipline
+//
+//	@Summary		Update parameterization discipline
+//	@Description	Endpoint for changing the priority or the mandatory flag of an offered discipline
+//	@Tags			parameterization discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string									true	"parameterization discipline uuid"
+//	@Param			body	body	dto.UpdateParameterizationDisciplineDto	false	"Update parameterization discipline dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-disciplines/{uuid} [patch]
+func (h *handler) UpdateParameterizationDiscipline(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateParameterizationDisciplineDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("parameterization discipline id is required", slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("parameterization discipline id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse parameterization discipline id: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid parameterization discipline id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.parameterizationDisciplineService.UpdateParameterizationDiscipline(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update parameterization discipline: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		if err.Error() == "parameterization discipline not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("parameterization discipline not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update parameterization discipline")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Parameterization discipline details
+//
+//	@Summary		Parameterization discipline details
+//	@Description	Get parameterization discipline by uuid
+//	@Tags			parameterization discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"parameterization discipline uuid"
+//	@Success		200	{object}	response.ParameterizationDisciplineResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-disciplines/{uuid} [get]
+func (h *handler) GetParameterizationDisciplineByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.parameterizationDisciplineService.GetParameterizationDisciplineByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get parameterization discipline: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		if err.Error() == "parameterization discipline not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("parameterization discipline not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get parameterization discipline")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Delete parameterization discipline
+//
+//	@Summary		Delete parameterization discipline
+//	@Description	Stop offering a discipline in a parameterization
+//	@Tags			parameterization discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"parameterization discipline uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-disciplines/{uuid} [delete]
+func (h *handler) DeleteParameterizationDiscipline(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.parameterizationDisciplineService.DeleteParameterizationDiscipline(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete parameterization discipline: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		if err.Error() == "parameterization discipline not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("parameterization discipline not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete parameterization discipline")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Get many parameterization disciplines by parameterization
+//
+//	@Summary		Get many parameterization disciplines by parameterization
+//	@Description	List the disciplines a parameterization offers, mandatory ones first and then by priority
+//	@Tags			parameterization discipline
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			parameterizationId	path	string	true	"parameterization id"
+//	@Success		200	{object}	response.ManyParameterizationDisciplinesResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/parameterization-disciplines/list-all/{parameterizationId} [get]
+func (h *handler) FindManyParameterizationDisciplinesByParameterizationId(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "parameterizationId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	parameterizationId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.parameterizationDisciplineService.FindManyParameterizationDisciplinesByParameterizationId(r.Context(), parameterizationId)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many parameterization disciplines: %v", err), slog.String("package", "handler_parameterization_discipline"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many parameterization disciplines")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
@@ -62,6 +62,12 @@ func (h *handler) CreateParameterization(w http.ResponseWriter, r *http.Request)
 			json.NewEncoder(w).Encode(msg)
 			return
 		}
+		if err.Error() == "tournamentSize must not be greater than populationSize" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
 		slog.Error(fmt.Sprintf("error to create parameterization: %v", err), slog.String("package", "handler_parameterization"))
 		w.WriteHeader(http.StatusBadRequest)
 	}
@@ -133,6 +139,12 @@ func (h *handler) UpdateParameterization(w http.ResponseWriter, r *http.Request)
 			json.NewEncoder(w).Encode(msg)
 			return
 		}
+		if err.Error() == "tournamentSize must not be greater than populationSize" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
 
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(err)
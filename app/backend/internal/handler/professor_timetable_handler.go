@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Professor timetable
+//
+//	@Summary		Timetable of a professor
+//	@Description	The week of a professor in a semester: their classes in the approved or published proposal of every course, or else its latest one, or only in the approved and published ones, grouped by weekday and shift, with the allocated hours checked against the hours to allocate
+//	@Tags			professor
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid		path		string	true	"professor uuid"
+//	@Param			semesterId	path		string	true	"semester id"
+//	@Param			approved	query		bool	false	"only the approved and published proposals"
+//	@Success		200			{object}	response.ProfessorTimetableResponse
+//	@Failure		400			{object}	httperr.RestErr
+//	@Failure		404			{object}	httperr.RestErr
+//	@Failure		500			{object}	httperr.RestErr
+//	@Router			/professors/{uuid}/timetable/{semesterId} [get]
+func (h *handler) GetProfessorTimetable(w http.ResponseWriter, r *http.Request) {
+	professorUUID, semesterId, approvedOnly, ok := parseProfessorTimetableParams(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.proposalService.GetProfessorTimetable(r.Context(), professorUUID, semesterId, approvedOnly)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get professor timetable: %v", err), slog.String("package", "handler_professor_timetable"))
+		writeProfessorTimetableError(w, err, "error to get professor timetable")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Export professor timetable
+//
+//	@Summary		Export the timetable of a professor to Excel
+//	@Description	Download the week of a professor in a semester as an .xlsx file with a workload summary sheet and a sheet with the classes
+//	@Tags			professor
+//	@Security		ApiKeyAuth
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			uuid		path	string	true	"professor uuid"
+//	@Param			semesterId	path	string	true	"semester id"
+//	@Param			approved	query	bool	false	"only the approved and published proposals"
+//	@Success		200	{file}	file
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/professors/{uuid}/timetable/{semesterId}/export [get]
+func (h *handler) ExportProfessorTimetable(w http.ResponseWriter, r *http.Request) {
+	h.writeProfessorTimetableFile(w, r, h.proposalService.ExportProfessorTimetable, func(w http.ResponseWriter, professorUUID uuid.UUID, content []byte) {
+		writeXlsx(w, fmt.Sprintf("timetable-%s.xlsx", professorUUID), content)
+	})
+}
+
+// Print professor timetable
+//
+//	@Summary		Printable timetable of a professor
+//	@Description	The week of a professor in a semester as an HTML page with a weekly grid, one column per weekday and one row per class time, laid out to be printed or saved as PDF from the browser
+//	@Tags			professor
+//	@Security		ApiKeyAuth
+//	@Produce		html
+//	@Param			uuid		path	string	true	"professor uuid"
+//	@Param			semesterId	path	string	true	"semester id"
+//	@Param			approved	query	bool	false	"only the approved and published proposals"
+//	@Success		200	{string}	string
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/professors/{uuid}/timetable/{semesterId}/print [get]
+func (h *handler) PrintProfessorTimetable(w http.ResponseWriter, r *http.Request) {
+	h.writeProfessorTimetableFile(w, r, h.proposalService.PrintProfessorTimetable, func(w http.ResponseWriter, professorUUID uuid.UUID, content []byte) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(content)
+	})
+}
+
+// writeProfessorTimetableFile renders the timetable with render and writes it with write.
+func (h *handler) writeProfessorTimetableFile(w http.ResponseWriter, r *http.Request,
+	render func(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) ([]byte, error),
+	write func(w http.ResponseWriter, professorUUID uuid.UUID, content []byte)) {
+	professorUUID, semesterId, approvedOnly, ok := parseProfessorTimetableParams(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := render(r.Context(), professorUUID, semesterId, approvedOnly)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to export professor timetable: %v", err), slog.String("package", "handler_professor_timetable"))
+		writeProfessorTimetableError(w, err, "error to export professor timetable")
+		return
+	}
+	write(w, professorUUID, res)
+}
+
+func parseProfessorTimetableParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, int64, bool, bool) {
+	professorUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_professor_timetable"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return uuid.Nil, 0, false, false
+	}
+	semesterId, err := strconv.ParseInt(chi.URLParam(r, "semesterId"), 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse semester id: %v", err), slog.String("package", "handler_professor_timetable"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse semester id")
+		json.NewEncoder(w).Encode(msg)
+		return uuid.Nil, 0, false, false
+	}
+	approvedOnly, err := parseOptionalBoolQuery(r, "approved")
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse approved: %v", err), slog.String("package", "handler_professor_timetable"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid approved")
+		json.NewEncoder(w).Encode(msg)
+		return uuid.Nil, 0, false, false
+	}
+	return professorUUID, semesterId, approvedOnly, true
+}
+
+func writeProfessorTimetableError(w http.ResponseWriter, err error, message string) {
+	switch err.Error() {
+	case "professor not found", "no proposals found for this semester":
+		w.WriteHeader(http.StatusNotFound)
+		msg := httperr.NewNotFoundError(err.Error())
+		json.NewEncoder(w).Encode(msg)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError(message)
+		json.NewEncoder(w).Encode(msg)
+	}
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Course calendar of a proposal
+//
+//	@Summary		Export the classes of a proposal to iCalendar
+//	@Description	Download the classes of the course in the proposal as an RFC 5545 .ics file. Each class is a weekly event from the start to the end of the semester, skipping its holidays, with the discipline as summary and the room as location. Events keep the uuid of their class, so importing the file again updates them
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Produce		text/calendar
+//	@Param			uuid	path	string	true	"proposal uuid"
+//	@Success		200	{file}	file
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/calendar [get]
+func (h *handler) ExportProposalCalendar(w http.ResponseWriter, r *http.Request) {
+	proposalUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_calendar"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.ExportProposalCalendar(r.Context(), proposalUUID, uuid.Nil)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to export proposal calendar: %v", err), slog.String("package", "handler_proposal_calendar"))
+		writeProposalCalendarError(w, err)
+		return
+	}
+	writeCalendar(w, fmt.Sprintf("proposal-%s.ics", proposalUUID), res)
+}
+
+// Professor calendar of a proposal
+//
+//	@Summary		Export the classes of a professor in a proposal to iCalendar
+//	@Description	Download the classes the professor teaches in the proposal as an RFC 5545 .ics file. Each class is a weekly event from the start to the end of the semester, skipping its holidays, with the discipline as summary and the room as location. Events keep the uuid of their class, so importing the file again updates them
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Produce		text/calendar
+//	@Param			uuid			path	string	true	"proposal uuid"
+//	@Param			professorUuid	path	string	true	"professor uuid"
+//	@Success		200	{file}	file
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/calendar/professor/{professorUuid} [get]
+func (h *handler) ExportProfessorCalendar(w http.ResponseWriter, r *http.Request) {
+	proposalUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_calendar"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	professorUUID, err := uuid.Parse(chi.URLParam(r, "professorUuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse professor id: %v", err), slog.String("package", "handler_proposal_calendar"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse professor id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.ExportProposalCalendar(r.Context(), proposalUUID, professorUUID)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to export professor calendar: %v", err), slog.String("package", "handler_proposal_calendar"))
+		writeProposalCalendarError(w, err)
+		return
+	}
+	writeCalendar(w, fmt.Sprintf("professor-%s.ics", professorUUID), res)
+}
+
+func writeCalendar(w http.ResponseWriter, filename string, content []byte) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
+func writeProposalCalendarError(w http.ResponseWriter, err error) {
+	switch err.Error() {
+	case "proposal not found", "professor not found":
+		w.WriteHeader(http.StatusNotFound)
+		msg := httperr.NewNotFoundError(err.Error())
+		json.NewEncoder(w).Encode(msg)
+	case "semester has no start and end dates":
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError(err.Error())
+		json.NewEncoder(w).Encode(msg)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to export calendar")
+		json.NewEncoder(w).Encode(msg)
+	}
+}
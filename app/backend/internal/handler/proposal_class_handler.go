@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/common/utils"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+)
+
+// Add proposal class
+//
+//	@Summary		Add a class to a proposal
+//	@Description	Endpoint for adding a class to a generated proposal, while it is a draft or under review. The edit is checked against the same constraints as the generation; when it breaks a hard one it is rejected with 409, unless force is set, in which case it is saved and flagged. Every saved edit is recorded as a new version of the proposal
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string							true	"proposal uuid"
+//	@Param			body	body	dto.CreateProposalClassDto	true	"Create proposal class dto"	true
+//	@Success		201	{object}	response.ProposalEditResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		409	{object}	response.ProposalEditResponse
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/classes [post]
+func (h *handler) CreateProposalClass(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateProposalClassDto
+
+	user, err := utils.DecodeJwt(r)
+	if err != nil {
+		slog.Error("error to decode jwt", slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode jwt")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	proposalUUID, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	res, err := h.geneticAlgorithmService.CreateProposalClass(r.Context(), proposalUUID, user.UUID, req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to add proposal class: %v", err), slog.String("package", "handler_proposal_class"))
+		writeProposalEditError(w, res, err, "error to add proposal class")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Update proposal class
+//
+//	@Summary		Move or reassign a proposal class
+//	@Description	Endpoint for moving a class of a generated proposal (day, HH:MM start and end) or changing its professor or room, while the proposal is a draft or under review. The edit is checked against the same constraints as the generation; when it breaks a hard one it is rejected with 409, unless force is set, in which case it is saved and flagged. Every saved edit is recorded as a new version of the proposal
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid		path	string							true	"proposal uuid"
+//	@Param			classUuid	path	string							true	"class uuid"
+//	@Param			body		body	dto.UpdateProposalClassDto	false	"Update proposal class dto"	true
+//	@Success		200	{object}	response.ProposalEditResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		409	{object}	response.ProposalEditResponse
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/classes/{classUuid} [patch]
+func (h *handler) UpdateProposalClass(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateProposalClassDto
+
+	user, err := utils.DecodeJwt(r)
+	if err != nil {
+		slog.Error("error to decode jwt", slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode jwt")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	proposalUUID, classUUID, ok := parseProposalClassIds(w, r)
+	if !ok {
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	res, err := h.geneticAlgorithmService.UpdateProposalClass(r.Context(), proposalUUID, classUUID, user.UUID, req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update proposal class: %v", err), slog.String("package", "handler_proposal_class"))
+		writeProposalEditError(w, res, err, "error to update proposal class")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Delete proposal class
+//
+//	@Summary		Remove a class from a proposal
+//	@Description	Endpoint for removing a class from a generated proposal, while it is a draft or under review. The edit is checked against the same constraints as the generation; when it breaks a hard one, such as leaving a section short of hours, it is rejected with 409, unless force is set, in which case it is saved and flagged. Every saved edit is recorded as a new version of the proposal
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid		path	string	true	"proposal uuid"
+//	@Param			classUuid	path	string	true	"class uuid"
+//	@Param			force		query	bool	false	"remove the class even if it breaks a hard constraint"
+//	@Param			reason		query	string	false	"why the class is removed, kept in the version history"
+//	@Success		200	{object}	response.ProposalEditResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		409	{object}	response.ProposalEditResponse
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/classes/{classUuid} [delete]
+func (h *handler) DeleteProposalClass(w http.ResponseWriter, r *http.Request) {
+	user, err := utils.DecodeJwt(r)
+	if err != nil {
+		slog.Error("error to decode jwt", slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode jwt")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	proposalUUID, classUUID, ok := parseProposalClassIds(w, r)
+	if !ok {
+		return
+	}
+	force, err := parseOptionalBoolQuery(r, "force")
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse force: %v", err), slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid force")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.geneticAlgorithmService.DeleteProposalClass(r.Context(), proposalUUID, classUUID, user.UUID, force, r.URL.Query().Get("reason"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete proposal class: %v", err), slog.String("package", "handler_proposal_class"))
+		writeProposalEditError(w, res, err, "error to delete proposal class")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+func parseProposalClassIds(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	proposalUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return uuid.Nil, uuid.Nil, false
+	}
+	classUUID, err := uuid.Parse(chi.URLParam(r, "classUuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse class id: %v", err), slog.String("package", "handler_proposal_class"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse class id")
+		json.NewEncoder(w).Encode(msg)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return proposalUUID, classUUID, true
+}
+
+// writeProposalEditError answers a failed edit. A rejected edit comes with the
+// violations it would bring, which are returned so the user can decide to force it.
+func writeProposalEditError(w http.ResponseWriter, res *response.ProposalEditResponse, err error, message string) {
+	switch err.Error() {
+	case "edit breaks hard constraints":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(res)
+	case "proposal not found", "class not found in proposal":
+		w.WriteHeader(http.StatusNotFound)
+		msg := httperr.NewNotFoundError(err.Error())
+		json.NewEncoder(w).Encode(msg)
+	case "proposal is read-only", "proposal has no parameterization", "class is locked", "discipline is not offered by the parameterization",
+		"professor is not eligible for the discipline", "room not found", "end time must be after start time", "invalid day of week":
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError(err.Error())
+		json.NewEncoder(w).Encode(msg)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError(message)
+		json.NewEncoder(w).Encode(msg)
+	}
+}
@@ -0,0 +1,466 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Proposal details
+//
+//	@Summary		Proposal details
+//	@Description	Get a generated proposal with its classes, fitness and generation metadata
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"proposal uuid"
+//	@Success		200	{object}	dto.ProposalDTO
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid} [get]
+func (h *handler) GetProposalByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.GetProposalByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get proposal: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get proposal")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Get many proposals
+//
+//	@Summary		Get many proposals
+//	@Description	Get many proposals, optionally filtered by semester, course and status
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			semester_id	query	int		false	"semester id"
+//	@Param			course_id	query	int		false	"course id"
+//	@Param			status		query	string	false	"draft, under_review, approved, published or archived"
+//	@Success		200	{object}	response.ManyProposalsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/list-all [get]
+func (h *handler) FindManyProposals(w http.ResponseWriter, r *http.Request) {
+	semesterId, err := parseOptionalIdQuery(r, "semester_id")
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse semester id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid semester id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	courseId, err := parseOptionalIdQuery(r, "course_id")
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse course id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid course id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+
+	res, err := h.proposalService.FindManyProposals(r.Context(), semesterId, courseId, status)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many proposals: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "invalid status" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError("invalid status")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many proposals")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Export a proposal
+//
+//	@Summary		Export proposal to Excel
+//	@Description	Download the proposal as an .xlsx file with a summary sheet and one sheet for its course
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			uuid	path	string	true	"proposal uuid"
+//	@Success		200	{file}	file
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/export [get]
+func (h *handler) ExportProposal(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.ExportProposal(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to export proposal: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to export proposal")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	writeXlsx(w, fmt.Sprintf("proposal-%s.xlsx", uuid), res)
+}
+
+// Export the semester offering
+//
+//	@Summary		Export semester offering to Excel
+//	@Description	Download the approved or published proposal of every course in the semester, or else its latest one, as an .xlsx file with a summary sheet and one sheet per course
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			semesterId	path	string	true	"semester id"
+//	@Success		200	{file}	file
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/export/semester/{semesterId} [get]
+func (h *handler) ExportSemesterOffering(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "semesterId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	semesterId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.ExportSemesterOffering(r.Context(), semesterId)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to export semester offering: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "no proposals found for this semester" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("no proposals found for this semester")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to export semester offering")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	writeXlsx(w, fmt.Sprintf("offering-semester-%d.xlsx", semesterId), res)
+}
+
+// Professor workload of a proposal
+//
+//	@Summary		Professor workload of a proposal
+//	@Description	Compare the hours each professor should teach with the hours allocated in the proposal
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"proposal uuid"
+//	@Param			only_mismatches	query	bool	false	"list only professors whose allocated hours differ from the planned ones"
+//	@Success		200	{object}	response.ManyProfessorWorkloadsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/workload [get]
+func (h *handler) GetProposalWorkload(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	onlyMismatches, err := parseOptionalBoolQuery(r, "only_mismatches")
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse only_mismatches: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid only_mismatches")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.GetProposalWorkload(r.Context(), uuid, onlyMismatches)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get proposal workload: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get proposal workload")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Professor workload of a semester
+//
+//	@Summary		Professor workload of a semester
+//	@Description	Compare the hours each professor should teach with the hours allocated in the approved or published proposal of every course in the semester, or else its latest one
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			semesterId	path	string	true	"semester id"
+//	@Param			only_mismatches	query	bool	false	"list only professors whose allocated hours differ from the planned ones"
+//	@Success		200	{object}	response.ManyProfessorWorkloadsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/workload/semester/{semesterId} [get]
+func (h *handler) GetSemesterWorkload(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "semesterId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	semesterId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	onlyMismatches, err := parseOptionalBoolQuery(r, "only_mismatches")
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse only_mismatches: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid only_mismatches")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.GetSemesterWorkload(r.Context(), semesterId, onlyMismatches)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get semester workload: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "no proposals found for this semester" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("no proposals found for this semester")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get semester workload")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+func writeXlsx(w http.ResponseWriter, filename string, content []byte) {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
+// parseOptionalIdQuery reads a numeric id from the query string, returning 0 when it is absent.
+func parseOptionalIdQuery(r *http.Request, name string) (int64, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// parseOptionalBoolQuery reads a boolean flag from the query string, returning false when it is absent.
+func parseOptionalBoolQuery(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// Semester meetings of a proposal
+//
+//	@Summary		Semester meetings of a proposal
+//	@Description	Expand the weekly classes of the proposal into the dated class meetings of the whole semester, skipping its holidays
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"proposal uuid"
+//	@Success		200	{object}	response.ProposalMeetingsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/meetings [get]
+func (h *handler) GetProposalMeetings(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.proposalService.GetProposalMeetings(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get proposal meetings: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "semester has no start and end dates" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get proposal meetings")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Compare proposals
+//
+//	@Summary		Compare two proposals
+//	@Description	Diff a proposal against a base one: classes added, removed or whose professor, day, shift, time or room changed, the weekly hours of every professor, the credits offered and the constraint violations of each
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid		path	string	true	"base proposal uuid"
+//	@Param			otherUuid	path	string	true	"uuid of the proposal compared with the base one"
+//	@Success		200	{object}	response.ProposalDiffResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/compare/{otherUuid} [get]
+func (h *handler) CompareProposals(w http.ResponseWriter, r *http.Request) {
+	baseUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	otherUUID, err := uuid.Parse(chi.URLParam(r, "otherUuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse other id: %v", err), slog.String("package", "handler_proposal"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse other id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.proposalService.CompareProposals(r.Context(), baseUUID, otherUUID)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to compare proposals: %v", err), slog.String("package", "handler_proposal"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to compare proposals")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
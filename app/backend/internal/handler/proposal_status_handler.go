@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/common/utils"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+)
+
+// Transition proposal
+//
+//	@Summary		Change the status of a proposal
+//	@Description	Endpoint for moving a proposal through its lifecycle, from draft to under_review, approved, published and archived. A proposal under review may go back to draft and an approved one back to review; any proposal but an archived one may be archived. A course has a single approved or published proposal per semester, and approved, published and archived proposals can no longer be edited
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string						true	"proposal uuid"
+//	@Param			body	body	dto.TransitionProposalDto	true	"Transition proposal dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/transitions [post]
+func (h *handler) TransitionProposal(w http.ResponseWriter, r *http.Request) {
+	var req dto.TransitionProposalDto
+
+	user, err := utils.DecodeJwt(r)
+	if err != nil {
+		slog.Error("error to decode jwt", slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode jwt")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	proposalUUID, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.proposalService.TransitionProposal(r.Context(), proposalUUID, user.UUID, req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to transition proposal: %v", err), slog.String("package", "handler_proposal_status"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "invalid status transition" || err.Error() == "course already has an approved proposal for this semester" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to transition proposal")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Proposal status history
+//
+//	@Summary		Status history of a proposal
+//	@Description	Get the current status of a proposal and every transition it went through, with who made it, when and why
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"proposal uuid"
+//	@Success		200	{object}	response.ManyProposalTransitionsResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/transitions [get]
+func (h *handler) FindManyProposalTransitions(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	proposalUUID, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_status"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.FindManyProposalTransitions(r.Context(), proposalUUID)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find proposal transitions: %v", err), slog.String("package", "handler_proposal_status"))
+		if err.Error() == "proposal not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("proposal not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find proposal transitions")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/common/utils"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Proposal versions
+//
+//	@Summary		List the versions of a proposal
+//	@Description	Endpoint for listing the history of a proposal, the latest version first. The generated classes are its first version and every edit or restore adds one, with its author, reason and fitness
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path		string	true	"proposal uuid"
+//	@Success		200		{object}	response.ManyProposalVersionsResponse
+//	@Failure		400		{object}	httperr.RestErr
+//	@Failure		404		{object}	httperr.RestErr
+//	@Failure		500		{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/versions [get]
+func (h *handler) FindManyProposalVersions(w http.ResponseWriter, r *http.Request) {
+	proposalUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_version"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.proposalService.FindManyProposalVersions(r.Context(), proposalUUID)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find proposal versions: %v", err), slog.String("package", "handler_proposal_version"))
+		writeProposalVersionError(w, err, "error to find proposal versions")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Proposal version
+//
+//	@Summary		Get a version of a proposal
+//	@Description	Endpoint for getting a past version of a proposal, with the classes, fitness and fitness breakdown it had
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path		string	true	"proposal uuid"
+//	@Param			version	path		int		true	"version number"
+//	@Success		200		{object}	response.ProposalVersionResponse
+//	@Failure		400		{object}	httperr.RestErr
+//	@Failure		404		{object}	httperr.RestErr
+//	@Failure		500		{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/versions/{version} [get]
+func (h *handler) GetProposalVersion(w http.ResponseWriter, r *http.Request) {
+	proposalUUID, version, ok := parseProposalVersion(w, r, "version")
+	if !ok {
+		return
+	}
+
+	res, err := h.proposalService.GetProposalVersion(r.Context(), proposalUUID, version)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get proposal version: %v", err), slog.String("package", "handler_proposal_version"))
+		writeProposalVersionError(w, err, "error to get proposal version")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Compare proposal versions
+//
+//	@Summary		Compare two versions of a proposal
+//	@Description	Diff a version of a proposal against a base version of it, as two proposals are compared: classes added, removed or changed, the weekly hours of every professor, the credits offered and the constraint violations of each
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid			path		string	true	"proposal uuid"
+//	@Param			version			path		int		true	"base version number"
+//	@Param			otherVersion	path		int		true	"number of the version compared with the base one"
+//	@Success		200				{object}	response.ProposalDiffResponse
+//	@Failure		400				{object}	httperr.RestErr
+//	@Failure		404				{object}	httperr.RestErr
+//	@Failure		500				{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/versions/{version}/compare/{otherVersion} [get]
+func (h *handler) CompareProposalVersions(w http.ResponseWriter, r *http.Request) {
+	proposalUUID, baseVersion, ok := parseProposalVersion(w, r, "version")
+	if !ok {
+		return
+	}
+	_, otherVersion, ok := parseProposalVersion(w, r, "otherVersion")
+	if !ok {
+		return
+	}
+
+	res, err := h.proposalService.CompareProposalVersions(r.Context(), proposalUUID, baseVersion, otherVersion)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to compare proposal versions: %v", err), slog.String("package", "handler_proposal_version"))
+		writeProposalVersionError(w, err, "error to compare proposal versions")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Restore proposal version
+//
+//	@Summary		Restore a version of a proposal
+//	@Description	Endpoint for bringing back the classes of an older version of a proposal, while it is a draft or under review. The history is kept: the restored classes are saved as a new version
+//	@Tags			proposal
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path		string							true	"proposal uuid"
+//	@Param			version	path		int								true	"version number"
+//	@Param			body	body		dto.RestoreProposalVersionDto	false	"Restore proposal version dto"
+//	@Success		201		{object}	response.ProposalVersionResponse
+//	@Failure		400		{object}	httperr.RestErr
+//	@Failure		404		{object}	httperr.RestErr
+//	@Failure		500		{object}	httperr.RestErr
+//	@Router			/proposals/{uuid}/versions/{version}/restore [post]
+func (h *handler) RestoreProposalVersion(w http.ResponseWriter, r *http.Request) {
+	var req dto.RestoreProposalVersionDto
+
+	user, err := utils.DecodeJwt(r)
+	if err != nil {
+		slog.Error("error to decode jwt", slog.String("package", "handler_proposal_version"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode jwt")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	proposalUUID, version, ok := parseProposalVersion(w, r, "version")
+	if !ok {
+		return
+	}
+	// the body is optional, it only carries the reason
+	if r.Body != http.NoBody {
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			slog.Error("error to decode body", "err", err, slog.String("package", "handler_proposal_version"))
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError("error to decode body")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		httpErr := validation.ValidateHttpData(req)
+		if httpErr != nil {
+			slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_proposal_version"))
+			w.WriteHeader(httpErr.Code)
+			json.NewEncoder(w).Encode(httpErr)
+			return
+		}
+	}
+
+	res, err := h.proposalService.RestoreProposalVersion(r.Context(), proposalUUID, user.UUID, version, req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to restore proposal version: %v", err), slog.String("package", "handler_proposal_version"))
+		writeProposalVersionError(w, err, "error to restore proposal version")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(res)
+}
+
+// parseProposalVersion reads the proposal uuid and the version number in the path param.
+func parseProposalVersion(w http.ResponseWriter, r *http.Request, param string) (uuid.UUID, int32, bool) {
+	proposalUUID, err := uuid.Parse(chi.URLParam(r, "uuid"))
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_proposal_version"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return uuid.Nil, 0, false
+	}
+	version, err := strconv.ParseInt(chi.URLParam(r, param), 10, 32)
+	if err != nil || version < 1 {
+		slog.Error(fmt.Sprintf("error to parse version: %v", err), slog.String("package", "handler_proposal_version"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse version")
+		json.NewEncoder(w).Encode(msg)
+		return uuid.Nil, 0, false
+	}
+	return proposalUUID, int32(version), true
+}
+
+func writeProposalVersionError(w http.ResponseWriter, err error, message string) {
+	switch err.Error() {
+	case "proposal not found", "proposal version not found":
+		w.WriteHeader(http.StatusNotFound)
+		msg := httperr.NewNotFoundError(err.Error())
+		json.NewEncoder(w).Encode(msg)
+	case "proposal is read-only":
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError(err.Error())
+		json.NewEncoder(w).Encode(msg)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError(message)
+		json.NewEncoder(w).Encode(msg)
+	}
+}
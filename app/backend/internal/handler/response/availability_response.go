@@ -4,7 +4,8 @@ type AvailabilityResponse struct {
 	Id          int64  `json:"'id'"`
 	UUID        string `json:"uuid"`
 	DayOfWeek   string `json:"dayOfWeek"`
-	Shift       string `json:"shift"`
+	StartTime   string `json:"start_time"`
+	EndTime     string `json:"end_time"`
 	ProfessorId int64  `json:"professor_id"`
 }
 
@@ -0,0 +1,26 @@
+package response
+
+type CurriculumMatrixResponse struct {
+	Id          int64                                `json:"id"`
+	UUID        string                               `json:"uuid"`
+	CourseId    int64                                `json:"course_id"`
+	Version     string                               `json:"version"`
+	Active      bool                                 `json:"active"`
+	Disciplines []CurriculumMatrixDisciplineResponse `json:"disciplines,omitempty"`
+}
+
+type CurriculumMatrixDisciplineResponse struct {
+	Id                 int64  `json:"id"`
+	UUID               string `json:"uuid"`
+	CurriculumMatrixId int64  `json:"curriculum_matrix_id"`
+	DisciplineId       int64  `json:"discipline_id"`
+	DisciplineCode     string `json:"discipline_code,omitempty"`
+	DisciplineName     string `json:"discipline_name,omitempty"`
+	Credits            int32  `json:"credits,omitempty"`
+	Term               int32  `json:"term"`
+	Mandatory          bool   `json:"mandatory"`
+}
+
+type ManyCurriculumMatricesResponse struct {
+	CurriculumMatrices []CurriculumMatrixResponse `json:"curriculum_matrices"`
+}
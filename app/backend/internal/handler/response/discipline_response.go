@@ -1,11 +1,15 @@
 package response
 
 type DisciplineResponse struct {
-	Id       int64  `json:"id"`
-	UUID     string `json:"uuid"`
-	Name     string `json:"name"`
-	Credits  int32  `json:"credits"`
-	CourseId int64  `json:"course_id"`
+	Id                int64  `json:"id"`
+	UUID              string `json:"uuid"`
+	Code              string `json:"code"`
+	Name              string `json:"name"`
+	Credits           int32  `json:"credits"`
+	CourseId          int64  `json:"course_id"`
+	RoomType          string `json:"room_type"`
+	ExpectedEnrolment int32  `json:"expected_enrolment"`
+	Term              int32  `json:"term"`
 }
 
 type ManyDisciplinesResponse struct {
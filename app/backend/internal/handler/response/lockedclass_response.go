@@ -0,0 +1,18 @@
+package response
+
+type LockedClassResponse struct {
+	Id                 int64  `json:"id"`
+	UUID               string `json:"uuid"`
+	ParameterizationId int64  `json:"parameterization_id"`
+	DisciplineId       int64  `json:"discipline_id"`
+	ProfessorId        int64  `json:"professor_id"`
+	RoomId             int64  `json:"room_id,omitempty"`
+	Section            int32  `json:"section"`
+	DayOfWeek          string `json:"day_of_week"`
+	StartTime          string `json:"start_time"`
+	EndTime            string `json:"end_time"`
+}
+
+type ManyLockedClassesResponse struct {
+	LockedClasses []LockedClassResponse `json:"locked_classes"`
+}
@@ -1,12 +1,21 @@
 package response
 
 type ParameterizationResponse struct {
-	Id                      int64  `json:"id"`
-	UUID                    string `json:"uuid"`
-	MaxCreditsToOffer       int32  `json:"maxCreditsToOffer"`
-	NumClassesPerDiscipline int32  `json:"numClassesPerDiscipline"`
-	SemesterId              int64  `json:"semester_id"`
-	CourseId                int64  `json:"course_id"`
+	Id                      int64   `json:"id"`
+	UUID                    string  `json:"uuid"`
+	MaxCreditsToOffer       int32   `json:"maxCreditsToOffer"`
+	NumClassesPerDiscipline int32   `json:"numClassesPerDiscipline"`
+	SemesterId              int64   `json:"semester_id"`
+	CourseId                int64   `json:"course_id"`
+	PopulationSize          int32   `json:"populationSize"`
+	Generations             int32   `json:"generations"`
+	TournamentSize          int32   `json:"tournamentSize"`
+	MutationRate            float64 `json:"mutationRate"`
+	HoursPerCredit          int32   `json:"hoursPerCredit"`
+	MaxBlockHours           int32   `json:"maxBlockHours"`
+	SpreadWeight            float64 `json:"spreadWeight"`
+	SameDayWeight           float64 `json:"sameDayWeight"`
+	IdleGapWeight           float64 `json:"idleGapWeight"`
 }
 
 type ManyParameterizationsResponse struct {
@@ -0,0 +1,25 @@
+package response
+
+type ParameterizationConstraintResponse struct {
+	Id                 int64   `json:"id"`
+	UUID               string  `json:"uuid"`
+	ParameterizationId int64   `json:"parameterization_id"`
+	Name               string  `json:"name"`
+	Enabled            bool    `json:"enabled"`
+	Weight             float64 `json:"weight"`
+}
+
+type ManyParameterizationConstraintsResponse struct {
+	ParameterizationConstraints []ParameterizationConstraintResponse `json:"parameterization_constraints"`
+}
+
+type ConstraintResponse struct {
+	Name          string  `json:"name"`
+	Description   string  `json:"description"`
+	Hard          bool    `json:"hard"`
+	DefaultWeight float64 `json:"default_weight"`
+}
+
+type ManyConstraintsResponse struct {
+	Constraints []ConstraintResponse `json:"constraints"`
+}
@@ -0,0 +1,14 @@
+package response
+
+type ParameterizationDisciplineResponse struct {
+	Id                 int64  `json:"id"`
+	UUID               string `json:"uuid"`
+	ParameterizationId int64  `json:"parameterization_id"`
+	DisciplineId       int64  `json:"discipline_id"`
+	Priority           int32  `json:"priority"`
+	Mandatory          bool   `json:"mandatory"`
+}
+
+type ManyParameterizationDisciplinesResponse struct {
+	ParameterizationDisciplines []ParameterizationDisciplineResponse `json:"parameterization_disciplines"`
+}
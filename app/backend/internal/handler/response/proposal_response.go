@@ -0,0 +1,84 @@
+package response
+
+import (
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"time"
+)
+
+type ManyProposalsResponse struct {
+	Proposals []dto.ProposalDTO `json:"proposals"`
+}
+
+type ProposalMeetingsResponse struct {
+	ProposalUUID string         `json:"proposal_uuid"`
+	SemesterId   int64          `json:"semester_id"`
+	StartDate    string         `json:"start_date"`
+	EndDate      string         `json:"end_date"`
+	Meetings     []dto.ClassDTO `json:"meetings"`
+}
+
+type ProposalDiffSummaryResponse struct {
+	ProposalUUID     string                   `json:"proposal_uuid"`
+	Version          int32                    `json:"version,omitempty"`
+	Fitness          float64                  `json:"fitness"`
+	Classes          int                      `json:"classes"`
+	Credits          int32                    `json:"credits"`
+	HardViolations   int                      `json:"hard_violations"`
+	SoftViolations   int                      `json:"soft_violations"`
+	FitnessBreakdown []dto.ConstraintScoreDTO `json:"fitness_breakdown,omitempty"`
+}
+
+type ClassChangeResponse struct {
+	Changes []string     `json:"changes"`
+	Base    dto.ClassDTO `json:"base"`
+	Other   dto.ClassDTO `json:"other"`
+}
+
+type ProfessorHoursDeltaResponse struct {
+	ProfessorId   int64   `json:"professor_id"`
+	ProfessorUUID string  `json:"professor_uuid"`
+	Name          string  `json:"name"`
+	BaseHours     float64 `json:"base_hours"`
+	OtherHours    float64 `json:"other_hours"`
+	Delta         float64 `json:"delta"`
+}
+
+type ProposalDiffResponse struct {
+	Base           ProposalDiffSummaryResponse   `json:"base"`
+	Other          ProposalDiffSummaryResponse   `json:"other"`
+	AddedClasses   []dto.ClassDTO                `json:"added_classes"`
+	RemovedClasses []dto.ClassDTO                `json:"removed_classes"`
+	ChangedClasses []ClassChangeResponse         `json:"changed_classes"`
+	ProfessorHours []ProfessorHoursDeltaResponse `json:"professor_hours"`
+}
+
+type ProposalTransitionResponse struct {
+	UUID       string    `json:"uuid"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	UserUUID   string    `json:"user_uuid,omitempty"`
+	UserName   string    `json:"user_name,omitempty"`
+	Comment    string    `json:"comment,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ManyProposalTransitionsResponse struct {
+	Status      string                       `json:"status"`
+	Transitions []ProposalTransitionResponse `json:"transitions"`
+}
+
+type ProposalVersionResponse struct {
+	UUID             string                   `json:"uuid"`
+	Version          int32                    `json:"version"`
+	UserUUID         string                   `json:"user_uuid,omitempty"`
+	UserName         string                   `json:"user_name,omitempty"`
+	Reason           string                   `json:"reason,omitempty"`
+	Fitness          float64                  `json:"fitness"`
+	CreatedAt        time.Time                `json:"created_at"`
+	FitnessBreakdown []dto.ConstraintScoreDTO `json:"fitness_breakdown,omitempty"`
+	Classes          []dto.ClassDTO           `json:"classes,omitempty"`
+}
+
+type ManyProposalVersionsResponse struct {
+	Versions []ProposalVersionResponse `json:"versions"`
+}
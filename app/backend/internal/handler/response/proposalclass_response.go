@@ -0,0 +1,22 @@
+package response
+
+import "github.com/robinsonvs/time-table-project/internal/dto"
+
+type ProposalViolationResponse struct {
+	Constraint string   `json:"constraint"`
+	Hard       bool     `json:"hard"`
+	Count      int      `json:"count"`
+	ClassUuids []string `json:"class_uuids,omitempty"`
+}
+
+type ProposalEditResponse struct {
+	ProposalUUID      string                      `json:"proposal_uuid"`
+	ClassUUID         string                      `json:"class_uuid"`
+	Applied           bool                        `json:"applied"`
+	Version           int32                       `json:"version,omitempty"`
+	Forced            bool                        `json:"forced"`
+	BrokenConstraints []string                    `json:"broken_constraints,omitempty"`
+	Fitness           float64                     `json:"fitness"`
+	FitnessBreakdown  []dto.ConstraintScoreDTO    `json:"fitness_breakdown,omitempty"`
+	Violations        []ProposalViolationResponse `json:"violations,omitempty"`
+}
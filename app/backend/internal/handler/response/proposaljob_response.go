@@ -0,0 +1,21 @@
+package response
+
+import "time"
+
+type ProposalJobResponse struct {
+	Id                   int64      `json:"id"`
+	UUID                 string     `json:"uuid"`
+	Status               string     `json:"status"`
+	Progress             int32      `json:"progress"`
+	Error                string     `json:"error,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	StartedAt            *time.Time `json:"started_at,omitempty"`
+	FinishedAt           *time.Time `json:"finished_at,omitempty"`
+	Seed                 int64      `json:"seed"`
+	ParameterizationUUID string     `json:"parameterization_uuid"`
+	ProposalUUID         string     `json:"proposal_uuid,omitempty"`
+}
+
+type ManyProposalJobsResponse struct {
+	ProposalJobs []ProposalJobResponse `json:"proposal_jobs"`
+}
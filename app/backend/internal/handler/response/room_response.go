@@ -0,0 +1,14 @@
+package response
+
+type RoomResponse struct {
+	Id       int64  `json:"id"`
+	UUID     string `json:"uuid"`
+	Name     string `json:"name"`
+	Capacity int32  `json:"capacity"`
+	Type     string `json:"type"`
+	Location string `json:"location"`
+}
+
+type ManyRoomsResponse struct {
+	Rooms []RoomResponse `json:"rooms"`
+}
@@ -1,9 +1,20 @@
 package response
 
 type SemesterResponse struct {
-	Id       int64  `json:"id"`
-	UUID     string `json:"uuid"`
-	Semester string `json:"semester"`
+	Id        int64                     `json:"id"`
+	UUID      string                    `json:"uuid"`
+	Semester  string                    `json:"semester"`
+	StartDate string                    `json:"start_date,omitempty"`
+	EndDate   string                    `json:"end_date,omitempty"`
+	Holidays  []SemesterHolidayResponse `json:"holidays,omitempty"`
+}
+
+type SemesterHolidayResponse struct {
+	Id          int64  `json:"id"`
+	UUID        string `json:"uuid"`
+	SemesterId  int64  `json:"semester_id"`
+	Date        string `json:"date"`
+	Description string `json:"description"`
 }
 
 type ManySemestersResponse struct {
@@ -0,0 +1,15 @@
+package response
+
+type ShiftHoursResponse struct {
+	Id        int64  `json:"id"`
+	UUID      string `json:"uuid"`
+	Shift     string `json:"shift"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	CourseId  int64  `json:"course_id,omitempty"`
+	Location  string `json:"location,omitempty"`
+}
+
+type ManyShiftHoursResponse struct {
+	ShiftHours []ShiftHoursResponse `json:"shift_hours"`
+}
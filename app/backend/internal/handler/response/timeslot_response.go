@@ -0,0 +1,15 @@
+package response
+
+type TimeSlotResponse struct {
+	Id        int64    `json:"id"`
+	UUID      string   `json:"uuid"`
+	Label     string   `json:"label"`
+	Shift     string   `json:"shift"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+	Weekdays  []string `json:"weekdays"`
+}
+
+type ManyTimeSlotsResponse struct {
+	TimeSlots []TimeSlotResponse `json:"time_slots"`
+}
@@ -0,0 +1,47 @@
+package response
+
+import "github.com/robinsonvs/time-table-project/internal/dto"
+
+type ProfessorWorkloadResponse struct {
+	ProfessorId    int64   `json:"professor_id"`
+	ProfessorUUID  string  `json:"professor_uuid"`
+	Name           string  `json:"name"`
+	PlannedHours   int32   `json:"planned_hours"`
+	AllocatedHours float64 `json:"allocated_hours"`
+	Delta          float64 `json:"delta"`
+	Status         string  `json:"status"`
+	Classes        int64   `json:"classes"`
+}
+
+type ManyProfessorWorkloadsResponse struct {
+	Workloads []ProfessorWorkloadResponse `json:"workloads"`
+}
+
+type ProfessorTimetableClassResponse struct {
+	ProposalUUID string       `json:"proposal_uuid"`
+	CourseName   string       `json:"course_name"`
+	Class        dto.ClassDTO `json:"class"`
+}
+
+type ProfessorTimetableShiftResponse struct {
+	Shift   string                            `json:"shift"`
+	Classes []ProfessorTimetableClassResponse `json:"classes"`
+}
+
+type ProfessorTimetableDayResponse struct {
+	DayOfWeek string                            `json:"day_of_week"`
+	Shifts    []ProfessorTimetableShiftResponse `json:"shifts"`
+}
+
+type ProfessorTimetableResponse struct {
+	ProfessorUUID  string                          `json:"professor_uuid"`
+	Name           string                          `json:"name"`
+	SemesterId     int64                           `json:"semester_id"`
+	SemesterName   string                          `json:"semester_name"`
+	ApprovedOnly   bool                            `json:"approved_only"`
+	PlannedHours   int32                           `json:"planned_hours"`
+	AllocatedHours float64                         `json:"allocated_hours"`
+	Delta          float64                         `json:"delta"`
+	Status         string                          `json:"status"`
+	Days           []ProfessorTimetableDayResponse `json:"days"`
+}
@@ -38,12 +38,18 @@ func InitRoutes(router chi.Router, h handler.Handler) {
 		r.Delete("/semesters/{uuid}", h.DeleteSemester)
 		r.Get("/semesters/{uuid}", h.GetSemesterByID)
 		r.Get("/semesters/list-all", h.FindManySemesters)
+		r.Post("/semester-holidays", h.CreateSemesterHoliday)
+		r.Patch("/semester-holidays/{uuid}", h.UpdateSemesterHoliday)
+		r.Delete("/semester-holidays/{uuid}", h.DeleteSemesterHoliday)
 
 		r.Post("/professors", h.CreateProfessor)
 		r.Patch("/professors/{uuid}", h.UpdateProfessor)
 		r.Delete("/professors/{uuid}", h.DeleteProfessor)
 		r.Get("/professors/{uuid}", h.GetProfessorByID)
 		r.Get("/professors/list-all", h.FindManyProfessors)
+		r.Get("/professors/{uuid}/timetable/{semesterId}", h.GetProfessorTimetable)
+		r.Get("/professors/{uuid}/timetable/{semesterId}/export", h.ExportProfessorTimetable)
+		r.Get("/professors/{uuid}/timetable/{semesterId}/print", h.PrintProfessorTimetable)
 
 		r.Post("/disciplines", h.CreateDiscipline)
 		r.Patch("/disciplines/{uuid}", h.UpdateDiscipline)
@@ -52,6 +58,12 @@ func InitRoutes(router chi.Router, h handler.Handler) {
 		r.Get("/disciplines/list-all", h.FindManyDisciplines)
 		r.Get("/disciplines/list-all/{courseId}", h.FindManyDisciplinesByCourseId)
 
+		r.Post("/rooms", h.CreateRoom)
+		r.Patch("/rooms/{uuid}", h.UpdateRoom)
+		r.Delete("/rooms/{uuid}", h.DeleteRoom)
+		r.Get("/rooms/{uuid}", h.GetRoomByID)
+		r.Get("/rooms/list-all", h.FindManyRooms)
+
 		r.Post("/availabilities", h.CreateAvailability)
 		r.Patch("/availabilities/{uuid}", h.UpdateAvailability)
 		r.Delete("/availabilities/{uuid}", h.DeleteAvailability)
@@ -66,10 +78,75 @@ func InitRoutes(router chi.Router, h handler.Handler) {
 		r.Get("/parameterizations/list-all", h.FindManyParameterizations)
 		r.Get("/parameterizations/list-all/{semesterId}", h.FindManyParameterizationsBySemesterId)
 
+		r.Post("/parameterization-disciplines", h.CreateParameterizationDiscipline)
+		r.Patch("/parameterization-disciplines/{uuid}", h.UpdateParameterizationDiscipline)
+		r.Delete("/parameterization-disciplines/{uuid}", h.DeleteParameterizationDiscipline)
+		r.Get("/parameterization-disciplines/{uuid}", h.GetParameterizationDisciplineByID)
+		r.Get("/parameterization-disciplines/list-all/{parameterizationId}", h.FindManyParameterizationDisciplinesByParameterizationId)
+
+		r.Post("/parameterization-constraints", h.CreateParameterizationConstraint)
+		r.Patch("/parameterization-constraints/{uuid}", h.UpdateParameterizationConstraint)
+		r.Delete("/parameterization-constraints/{uuid}", h.DeleteParameterizationConstraint)
+		r.Get("/parameterization-constraints/available", h.FindManyConstraints)
+		r.Get("/parameterization-constraints/{uuid}", h.GetParameterizationConstraintByID)
+		r.Get("/parameterization-constraints/list-all/{parameterizationId}", h.FindManyParameterizationConstraintsByParameterizationId)
+
+		r.Post("/curriculum-matrices", h.CreateCurriculumMatrix)
+		r.Patch("/curriculum-matrices/{uuid}", h.UpdateCurriculumMatrix)
+		r.Delete("/curriculum-matrices/{uuid}", h.DeleteCurriculumMatrix)
+		r.Get("/curriculum-matrices/{uuid}", h.GetCurriculumMatrixByID)
+		r.Get("/curriculum-matrices/list-all/{courseId}", h.FindManyCurriculumMatricesByCourseId)
+		r.Post("/curriculum-matrices/{uuid}/import", h.ImportCurriculumMatrix)
+		r.Post("/curriculum-matrix-disciplines", h.CreateCurriculumMatrixDiscipline)
+		r.Patch("/curriculum-matrix-disciplines/{uuid}", h.UpdateCurriculumMatrixDiscipline)
+		r.Delete("/curriculum-matrix-disciplines/{uuid}", h.DeleteCurriculumMatrixDiscipline)
+
+		r.Post("/shift-hours", h.CreateShiftHours)
+		r.Patch("/shift-hours/{uuid}", h.UpdateShiftHours)
+		r.Delete("/shift-hours/{uuid}", h.DeleteShiftHours)
+		r.Get("/shift-hours/{uuid}", h.GetShiftHoursByID)
+		r.Get("/shift-hours/list-all", h.FindManyShiftHours)
+		r.Get("/shift-hours/course/{courseId}", h.FindShiftHoursForCourse)
+
+		r.Post("/time-slots", h.CreateTimeSlot)
+		r.Patch("/time-slots/{uuid}", h.UpdateTimeSlot)
+		r.Delete("/time-slots/{uuid}", h.DeleteTimeSlot)
+		r.Get("/time-slots/{uuid}", h.GetTimeSlotByID)
+		r.Get("/time-slots/list-all", h.FindManyTimeSlots)
+
+		r.Post("/locked-classes", h.CreateLockedClass)
+		r.Post("/locked-classes/proposal/{uuid}", h.LockProposalClasses)
+		r.Patch("/locked-classes/{uuid}", h.UpdateLockedClass)
+		r.Delete("/locked-classes/{uuid}", h.DeleteLockedClass)
+		r.Get("/locked-classes/{uuid}", h.GetLockedClassByID)
+		r.Get("/locked-classes/list-all/{parameterizationId}", h.FindManyLockedClassesByParameterizationId)
+
 		r.Post("/eligible-disciplines", h.CreateEligibleDiscipline)
 		r.Delete("/eligible-disciplines", h.DeleteEligibleDiscipline)
 
 		r.Post("/generate-proposal/{parameterizationID}", h.GenerateProposal)
+		r.Get("/proposal-jobs/{uuid}", h.GetProposalJobByID)
+		r.Get("/proposal-jobs/list-all", h.FindManyProposalJobs)
+		r.Get("/proposal-jobs/list-all/{parameterizationId}", h.FindManyProposalJobsByParameterizationId)
+		r.Get("/proposals/{uuid}", h.GetProposalByID)
+		r.Get("/proposals/list-all", h.FindManyProposals)
+		r.Get("/proposals/{uuid}/export", h.ExportProposal)
+		r.Get("/proposals/export/semester/{semesterId}", h.ExportSemesterOffering)
+		r.Get("/proposals/{uuid}/workload", h.GetProposalWorkload)
+		r.Get("/proposals/workload/semester/{semesterId}", h.GetSemesterWorkload)
+		r.Get("/proposals/{uuid}/meetings", h.GetProposalMeetings)
+		r.Get("/proposals/{uuid}/calendar", h.ExportProposalCalendar)
+		r.Get("/proposals/{uuid}/calendar/professor/{professorUuid}", h.ExportProfessorCalendar)
+		r.Get("/proposals/{uuid}/compare/{otherUuid}", h.CompareProposals)
+		r.Post("/proposals/{uuid}/transitions", h.TransitionProposal)
+		r.Get("/proposals/{uuid}/transitions", h.FindManyProposalTransitions)
+		r.Post("/proposals/{uuid}/classes", h.CreateProposalClass)
+		r.Patch("/proposals/{uuid}/classes/{classUuid}", h.UpdateProposalClass)
+		r.Delete("/proposals/{uuid}/classes/{classUuid}", h.DeleteProposalClass)
+		r.Get("/proposals/{uuid}/versions", h.FindManyProposalVersions)
+		r.Get("/proposals/{uuid}/versions/{version}", h.GetProposalVersion)
+		r.Get("/proposals/{uuid}/versions/{version}/compare/{otherVersion}", h.CompareProposalVersions)
+		r.Post("/proposals/{uuid}/versions/{version}/restore", h.RestoreProposalVersion)
 
 	})
 
@@ -15,7 +15,7 @@ import (
 // Create semester
 //
 //	@Summary		Create new semester
-//	@Description	Endpoint for create semester
+//	@Description	Endpoint for create semester, optionally with the first and last days of classes (YYYY-MM-DD)
 //	@Tags			semester
 //	@Security		ApiKeyAuth
 //	@Accept			json
@@ -55,14 +55,17 @@ func (h *handler) CreateSemester(w http.ResponseWriter, r *http.Request) {
 
 	err = h.semesterService.CreateSemester(r.Context(), req)
 	if err != nil {
-		if err.Error() == "semester not found" {
-			w.WriteHeader(http.StatusNotFound)
-			msg := httperr.NewNotFoundError("semester not found")
+		slog.Error(fmt.Sprintf("error to create semester: %v", err), slog.String("package", "handler_semester"))
+		if err.Error() == "start date and end date must be set together" || err.Error() == "end date must not be before start date" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
 			json.NewEncoder(w).Encode(msg)
 			return
 		}
-		slog.Error(fmt.Sprintf("error to create semester: %v", err), slog.String("package", "handler_semester"))
-		w.WriteHeader(http.StatusBadRequest)
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create semester")
+		json.NewEncoder(w).Encode(msg)
+		return
 	}
 	w.WriteHeader(http.StatusCreated)
 }
@@ -132,6 +135,12 @@ func (h *handler) UpdateSemester(w http.ResponseWriter, r *http.Request) {
 			json.NewEncoder(w).Encode(msg)
 			return
 		}
+		if err.Error() == "start date and end date must be set together" || err.Error() == "end date must not be before start date" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
 
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(err)
@@ -142,7 +151,7 @@ func (h *handler) UpdateSemester(w http.ResponseWriter, r *http.Request) {
 // Semester details
 //
 //	@Summary		Semester details
-//	@Description	Get semester by uuid
+//	@Description	Get semester by uuid, with its holidays
 //	@Tags			semester
 //	@Security		ApiKeyAuth
 //	@Accept			json
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+)
+
+// Create semester holiday
+//
+//	@Summary		Add a holiday to a semester
+//	@Description	Endpoint for marking a day of the semester (YYYY-MM-DD) as a day without classes
+//	@Tags			semester holiday
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateSemesterHolidayDto	true	"Create semester holiday dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/semester-holidays [post]
+func (h *handler) CreateSemesterHoliday(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateSemesterHolidayDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.semesterService.CreateSemesterHoliday(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create semester holiday: %v", err), slog.String("package", "handler_semester_holiday"))
+		if err.Error() == "semester not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("semester not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "holiday is outside the semester" || err.Error() == "semester already has a holiday on this date" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create semester holiday")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update semester holiday
+//
+//	@Summary		Update semester holiday
+//	@Description	Endpoint for moving a semester holiday to another day or changing its description
+//	@Tags			semester holiday
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string									true	"semester holiday uuid"
+//	@Param			body	body	dto.UpdateSemesterHolidayDto	false	"Update semester holiday dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/semester-holidays/{uuid} [patch]
+func (h *handler) UpdateSemesterHoliday(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateSemesterHolidayDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("semester holiday id is required", slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("semester holiday id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse semester holiday id: %v", err), slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid semester holiday id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.semesterService.UpdateSemesterHoliday(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update semester holiday: %v", err), slog.String("package", "handler_semester_holiday"))
+		if err.Error() == "semester holiday not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("semester holiday not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "holiday is outside the semester" || err.Error() == "semester already has a holiday on this date" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update semester holiday")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Delete semester holiday
+//
+//	@Summary		Delete semester holiday
+//	@Description	Remove a holiday from a semester
+//	@Tags			semester holiday
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"semester holiday uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/semester-holidays/{uuid} [delete]
+func (h *handler) DeleteSemesterHoliday(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_semester_holiday"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.semesterService.DeleteSemesterHoliday(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete semester holiday: %v", err), slog.String("package", "handler_semester_holiday"))
+		if err.Error() == "semester holiday not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("semester holiday not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete semester holiday")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
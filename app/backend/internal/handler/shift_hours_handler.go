@@ -0,0 +1,319 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// Create shift hours
+//
+//	@Summary		Create new shift hours
+//	@Description	Set the hours of a shift for a course, for the courses at a location or, with neither given, for the whole institution
+//	@Tags			shift hours
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateShiftHoursDto	true	"Create shift hours dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/shift-hours [post]
+func (h *handler) CreateShiftHours(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateShiftHoursDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.shiftHoursService.CreateShiftHours(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create shift hours: %v", err), slog.String("package", "handler_shift_hours"))
+		if err.Error() == "shift hours are set for a course or a location, not both" ||
+			err.Error() == "end time must be after start time" ||
+			err.Error() == "shift hours already exist for this scope" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create shift hours")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update shift hours
+//
+//	@Summary		Update shift hours
+//	@Description	Endpoint for update the start and end of shift hours
+//	@Tags			shift hours
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string					true	"shift hours uuid"
+//	@Param			body	body	dto.UpdateShiftHoursDto	false	"Update shift hours dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/shift-hours/{uuid} [patch]
+func (h *handler) UpdateShiftHours(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateShiftHoursDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("shift hours id is required", slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("shift hours id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse shift hours id: %v", err), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid shift hours id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.shiftHoursService.UpdateShiftHours(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update shift hours: %v", err), slog.String("package", "handler_shift_hours"))
+		if err.Error() == "shift hours not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("shift hours not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "end time must be after start time" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError("end time must be after start time")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update shift hours")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Shift hours details
+//
+//	@Summary		Shift hours details
+//	@Description	Get shift hours by uuid
+//	@Tags			shift hours
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"shift hours uuid"
+//	@Success		200	{object}	response.ShiftHoursResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/shift-hours/{uuid} [get]
+func (h *handler) GetShiftHoursByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.shiftHoursService.GetShiftHoursByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get shift hours: %v", err), slog.String("package", "handler_shift_hours"))
+		if err.Error() == "shift hours not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("shift hours not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get shift hours")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Delete shift hours
+//
+//	@Summary		Delete shift hours
+//	@Description	delete shift hours by uuid, falling back to the less specific hours of the shift
+//	@Tags			shift hours
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"shift hours uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/shift-hours/{uuid} [delete]
+func (h *handler) DeleteShiftHours(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.shiftHoursService.DeleteShiftHours(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete shift hours: %v", err), slog.String("package", "handler_shift_hours"))
+		if err.Error() == "shift hours not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("shift hours not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete shift hours")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Get many shift hours
+//
+//	@Summary		Get many shift hours
+//	@Description	List every configured shift hours
+//	@Tags			shift hours
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.ManyShiftHoursResponse
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/shift-hours/list-all [get]
+func (h *handler) FindManyShiftHours(w http.ResponseWriter, r *http.Request) {
+	res, err := h.shiftHoursService.FindManyShiftHours(r.Context())
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many shift hours: %v", err), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many shift hours")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Get shift hours of a course
+//
+//	@Summary		Get shift hours of a course
+//	@Description	The hours each shift has for a course: its own, the ones of its location, the institution-wide ones or the defaults, whichever is the most specific
+//	@Tags			shift hours
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			courseId	path	string	true	"course id"
+//	@Success		200	{object}	response.ManyShiftHoursResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/shift-hours/course/{courseId} [get]
+func (h *handler) FindShiftHoursForCourse(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "courseId")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	courseId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	res, err := h.shiftHoursService.FindShiftHoursForCourse(r.Context(), courseId)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find shift hours for course: %v", err), slog.String("package", "handler_shift_hours"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find shift hours for course")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/httperr"
+	"github.com/robinsonvs/time-table-project/internal/handler/validation"
+	"log/slog"
+	"net/http"
+)
+
+// Create time slot
+//
+//	@Summary		Create new time slot
+//	@Description	Add a period to the time grid classes are scheduled on. Without weekdays the period is given from Monday to Friday
+//	@Tags			time slot
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	dto.CreateTimeSlotDto	true	"Create time slot dto"	true
+//	@Success		201
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/time-slots [post]
+func (h *handler) CreateTimeSlot(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateTimeSlotDto
+
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_time_slot"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+
+	err = h.timeSlotService.CreateTimeSlot(r.Context(), req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to create time slot: %v", err), slog.String("package", "handler_time_slot"))
+		if err.Error() == "end time must be after start time" ||
+			err.Error() == "time slot label already exists" ||
+			err.Error() == "time slot overlaps another one" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to create time slot")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Update time slot
+//
+//	@Summary		Update time slot
+//	@Description	Endpoint for update time slot
+//	@Tags			time slot
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string					true	"time slot uuid"
+//	@Param			body	body	dto.UpdateTimeSlotDto	false	"Update time slot dto"	true
+//	@Success		200
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/time-slots/{uuid} [patch]
+func (h *handler) UpdateTimeSlot(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateTimeSlotDto
+
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("time slot id is required", slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("time slot id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse time slot id: %v", err), slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("invalid time slot id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	if r.Body == http.NoBody {
+		slog.Error("body is empty", slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("body is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		slog.Error("error to decode body", "err", err, slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to decode body")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	httpErr := validation.ValidateHttpData(req)
+	if httpErr != nil {
+		slog.Error(fmt.Sprintf("error to validate data: %v", httpErr), slog.String("package", "handler_time_slot"))
+		w.WriteHeader(httpErr.Code)
+		json.NewEncoder(w).Encode(httpErr)
+		return
+	}
+	err = h.timeSlotService.UpdateTimeSlot(r.Context(), req, uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to update time slot: %v", err), slog.String("package", "handler_time_slot"))
+		if err.Error() == "time slot not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("time slot not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		if err.Error() == "end time must be after start time" ||
+			err.Error() == "time slot label already exists" ||
+			err.Error() == "time slot overlaps another one" {
+			w.WriteHeader(http.StatusBadRequest)
+			msg := httperr.NewBadRequestError(err.Error())
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to update time slot")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+}
+
+// Time slot details
+//
+//	@Summary		Time slot details
+//	@Description	Get time slot by uuid
+//	@Tags			time slot
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"time slot uuid"
+//	@Success		200	{object}	response.TimeSlotResponse
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/time-slots/{uuid} [get]
+func (h *handler) GetTimeSlotByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+
+	res, err := h.timeSlotService.GetTimeSlotByID(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to get time slot: %v", err), slog.String("package", "handler_time_slot"))
+		if err.Error() == "time slot not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("time slot not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to get time slot")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
+
+// Delete time slot
+//
+//	@Summary		Delete time slot
+//	@Description	delete time slot by uuid
+//	@Tags			time slot
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			uuid	path	string	true	"time slot uuid"
+//	@Success		204
+//	@Failure		400	{object}	httperr.RestErr
+//	@Failure		404	{object}	httperr.RestErr
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/time-slots/{uuid} [delete]
+func (h *handler) DeleteTimeSlot(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "uuid")
+	if id == "" {
+		slog.Error("id is empty", slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("id is required")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to parse id: %v", err), slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusBadRequest)
+		msg := httperr.NewBadRequestError("error to parse id")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	err = h.timeSlotService.DeleteTimeSlot(r.Context(), uuid)
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to delete time slot: %v", err), slog.String("package", "handler_time_slot"))
+		if err.Error() == "time slot not found" {
+			w.WriteHeader(http.StatusNotFound)
+			msg := httperr.NewNotFoundError("time slot not found")
+			json.NewEncoder(w).Encode(msg)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to delete time slot")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Get many time slots
+//
+//	@Summary		Get many time slots
+//	@Description	List the time grid, in the order the periods start
+//	@Tags			time slot
+//	@Security		ApiKeyAuth
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.ManyTimeSlotsResponse
+//	@Failure		500	{object}	httperr.RestErr
+//	@Router			/time-slots/list-all [get]
+func (h *handler) FindManyTimeSlots(w http.ResponseWriter, r *http.Request) {
+	res, err := h.timeSlotService.FindManyTimeSlots(r.Context())
+	if err != nil {
+		slog.Error(fmt.Sprintf("error to find many time slots: %v", err), slog.String("package", "handler_time_slot"))
+		w.WriteHeader(http.StatusInternalServerError)
+		msg := httperr.NewInternalServerError("error to find many time slots")
+		json.NewEncoder(w).Encode(msg)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
@@ -48,6 +48,14 @@ func ValidateHttpData(d interface{}) *httperr.RestErr {
 				cause.Message = fmt.Sprintf("%s must be less than %s", fieldName, e.Param())
 				cause.Field = fieldName
 				cause.Value = e.Value()
+			case "gt":
+				cause.Message = fmt.Sprintf("%s must be greater than %s", fieldName, e.Param())
+				cause.Field = fieldName
+				cause.Value = e.Value()
+			case "lte":
+				cause.Message = fmt.Sprintf("%s must be less than or equal to %s", fieldName, e.Param())
+				cause.Field = fieldName
+				cause.Value = e.Value()
 			case "email":
 				cause.Message = fmt.Sprintf("%s is not a valid email", fieldName)
 				cause.Field = fieldName
@@ -56,6 +64,18 @@ func ValidateHttpData(d interface{}) *httperr.RestErr {
 				cause.Message = fmt.Sprintf("%s must contain at least one of the following characters: !@#$%%*", fieldName)
 				cause.Field = fieldName
 				cause.Value = e.Value()
+			case "oneof":
+				cause.Message = fmt.Sprintf("%s must be one of: %s", fieldName, e.Param())
+				cause.Field = fieldName
+				cause.Value = e.Value()
+			case "datetime":
+				cause.Message = fmt.Sprintf("%s must be in the %s format", fieldName, e.Param())
+				cause.Field = fieldName
+				cause.Value = e.Value()
+			case "unique":
+				cause.Message = fmt.Sprintf("%s must not repeat values", fieldName)
+				cause.Field = fieldName
+				cause.Value = e.Value()
 			default:
 				cause.Message = "invalid field"
 				cause.Field = fieldName
@@ -12,7 +12,8 @@ func (r *repository) CreateAvailability(ctx context.Context, u *entity.Availabil
 	err := r.queries.CreateAvailability(ctx, sqlc.CreateAvailabilityParams{
 		Uuid:        u.UUID,
 		Dayofweek:   u.DayOfWeek,
-		Shift:       u.Shift,
+		StartTime:   u.StartTime,
+		EndTime:     u.EndTime,
 		ProfessorID: u.ProfessorID,
 	})
 	if err != nil {
@@ -31,7 +32,8 @@ func (r *repository) FindAvailabilityByID(ctx context.Context, uuid uuid.UUID) (
 	availabilityEntity := entity.AvailabilityEntity{
 		UUID:        availability.Uuid,
 		DayOfWeek:   availability.Dayofweek,
-		Shift:       availability.Shift,
+		StartTime:   availability.StartTime,
+		EndTime:     availability.EndTime,
 		ProfessorID: availability.ProfessorID,
 	}
 
@@ -42,7 +44,8 @@ func (r *repository) UpdateAvailability(ctx context.Context, u *entity.Availabil
 	err := r.queries.UpdateAvailability(ctx, sqlc.UpdateAvailabilityParams{
 		Uuid:      u.UUID,
 		DayOfWeek: sql.NullString{String: u.DayOfWeek, Valid: u.DayOfWeek != ""},
-		Shift:     sql.NullString{String: u.Shift, Valid: u.Shift != ""},
+		StartTime: sql.NullTime{Time: u.StartTime, Valid: !u.StartTime.IsZero()},
+		EndTime:   sql.NullTime{Time: u.EndTime, Valid: !u.EndTime.IsZero()},
 	})
 
 	if err != nil {
@@ -74,7 +77,8 @@ func (r *repository) FindManyAvailabilities(ctx context.Context) ([]entity.Avail
 			ID:          availability.ID,
 			UUID:        availability.Uuid,
 			DayOfWeek:   availability.Dayofweek,
-			Shift:       availability.Shift,
+			StartTime:   availability.StartTime,
+			EndTime:     availability.EndTime,
 			ProfessorID: availability.ProfessorID,
 		}
 
@@ -95,7 +99,8 @@ func (r *repository) FindManyAvailabilitiesByProfessorId(ctx context.Context, pr
 			ID:          availability.ID,
 			UUID:        availability.Uuid,
 			DayOfWeek:   availability.Dayofweek,
-			Shift:       availability.Shift,
+			StartTime:   availability.StartTime,
+			EndTime:     availability.EndTime,
 			ProfessorID: availability.ProfessorID,
 		}
 
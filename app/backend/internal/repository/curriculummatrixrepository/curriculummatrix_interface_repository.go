@@ -0,0 +1,40 @@
+package curriculummatrixrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewCurriculumMatrixRepository(db *sql.DB, q *sqlc.Queries) CurriculumMatrixRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type CurriculumMatrixRepository interface {
+	CreateCurriculumMatrix(ctx context.Context, u *entity.CurriculumMatrixEntity) error
+	FindCurriculumMatrixByID(ctx context.Context, uuid uuid.UUID) (*entity.CurriculumMatrixEntity, error)
+	FindCurriculumMatrixByVersion(ctx context.Context, courseId int64, version string) (*entity.CurriculumMatrixEntity, error)
+	UpdateCurriculumMatrix(ctx context.Context, u *entity.CurriculumMatrixEntity) error
+	DeleteCurriculumMatrix(ctx context.Context, uuid uuid.UUID) error
+	FindManyCurriculumMatricesByCourseId(ctx context.Context, courseId int64) ([]entity.CurriculumMatrixEntity, error)
+	CreateCurriculumMatrixDiscipline(ctx context.Context, u *entity.CurriculumMatrixDisciplineEntity) error
+	FindCurriculumMatrixDisciplineByID(ctx context.Context, uuid uuid.UUID) (*entity.CurriculumMatrixDisciplineEntity, error)
+	FindCurriculumMatrixDisciplineByDisciplineId(ctx context.Context, curriculumMatrixId, disciplineId int64) (*entity.CurriculumMatrixDisciplineEntity, error)
+	FindDisciplineOfCurriculumMatrixCourseByCode(ctx context.Context, curriculumMatrixId int64, code string) (*entity.DisciplineEntity, error)
+	IsDisciplineOfCurriculumMatrixCourse(ctx context.Context, curriculumMatrixId, disciplineId int64) (bool, error)
+	UpdateCurriculumMatrixDiscipline(ctx context.Context, u *entity.CurriculumMatrixDisciplineEntity) error
+	DeleteCurriculumMatrixDiscipline(ctx context.Context, uuid uuid.UUID) error
+	ReplaceCurriculumMatrixDisciplines(ctx context.Context, curriculumMatrixId int64, disciplines []entity.CurriculumMatrixDisciplineEntity) error
+	FindManyCurriculumMatrixDisciplinesByCurriculumMatrixId(ctx context.Context, curriculumMatrixId int64) ([]entity.CurriculumMatrixDisciplineEntity, error)
+	FindActiveCurriculumMatrixDisciplinesByCourseId(ctx context.Context, courseId int64) ([]entity.CurriculumMatrixDisciplineEntity, error)
+}
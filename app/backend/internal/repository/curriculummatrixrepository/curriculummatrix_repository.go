@@ -0,0 +1,276 @@
+package curriculummatrixrepository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/repository/transaction"
+)
+
+// CreateCurriculumMatrix creates the matrix; an active one takes the place of the version
+// active so far, as a course has a single active version.
+func (r *repository) CreateCurriculumMatrix(ctx context.Context, u *entity.CurriculumMatrixEntity) error {
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		if u.Active {
+			if err := q.DeactivateCurriculumMatricesByCourseId(ctx, u.CourseID); err != nil {
+				return err
+			}
+		}
+
+		return q.CreateCurriculumMatrix(ctx, sqlc.CreateCurriculumMatrixParams{
+			Uuid:     u.UUID,
+			CourseID: u.CourseID,
+			Version:  u.Version,
+			Active:   u.Active,
+		})
+	})
+}
+
+func (r *repository) FindCurriculumMatrixByID(ctx context.Context, uuid uuid.UUID) (*entity.CurriculumMatrixEntity, error) {
+	curriculumMatrix, err := r.queries.FindCurriculumMatrixByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculumMatrixEntity := toCurriculumMatrixEntity(curriculumMatrix)
+	return &curriculumMatrixEntity, nil
+}
+
+func (r *repository) FindCurriculumMatrixByVersion(ctx context.Context, courseId int64, version string) (*entity.CurriculumMatrixEntity, error) {
+	curriculumMatrix, err := r.queries.FindCurriculumMatrixByVersion(ctx, sqlc.FindCurriculumMatrixByVersionParams{
+		CourseID: courseId,
+		Version:  version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	curriculumMatrixEntity := toCurriculumMatrixEntity(curriculumMatrix)
+	return &curriculumMatrixEntity, nil
+}
+
+// UpdateCurriculumMatrix updates the matrix; activating it deactivates the other versions of the course.
+func (r *repository) UpdateCurriculumMatrix(ctx context.Context, u *entity.CurriculumMatrixEntity) error {
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		if u.Active {
+			if err := q.DeactivateCurriculumMatricesByCourseId(ctx, u.CourseID); err != nil {
+				return err
+			}
+		}
+
+		return q.UpdateCurriculumMatrix(ctx, sqlc.UpdateCurriculumMatrixParams{
+			Uuid:    u.UUID,
+			Version: u.Version,
+			Active:  u.Active,
+		})
+	})
+}
+
+func (r *repository) DeleteCurriculumMatrix(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteCurriculumMatrix(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManyCurriculumMatricesByCourseId(ctx context.Context, courseId int64) ([]entity.CurriculumMatrixEntity, error) {
+	curriculumMatrices, err := r.queries.FindManyCurriculumMatricesByCourseId(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	var curriculumMatricesEntity []entity.CurriculumMatrixEntity
+	for _, curriculumMatrix := range curriculumMatrices {
+		curriculumMatricesEntity = append(curriculumMatricesEntity, toCurriculumMatrixEntity(curriculumMatrix))
+	}
+	return curriculumMatricesEntity, nil
+}
+
+func (r *repository) CreateCurriculumMatrixDiscipline(ctx context.Context, u *entity.CurriculumMatrixDisciplineEntity) error {
+	err := r.queries.CreateCurriculumMatrixDiscipline(ctx, sqlc.CreateCurriculumMatrixDisciplineParams{
+		Uuid:               u.UUID,
+		CurriculumMatrixID: u.CurriculumMatrixID,
+		DisciplineID:       u.DisciplineID,
+		Term:               u.Term,
+		Mandatory:          u.Mandatory,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindCurriculumMatrixDisciplineByID(ctx context.Context, uuid uuid.UUID) (*entity.CurriculumMatrixDisciplineEntity, error) {
+	curriculumMatrixDiscipline, err := r.queries.FindCurriculumMatrixDisciplineByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculumMatrixDisciplineEntity := toCurriculumMatrixDisciplineEntity(curriculumMatrixDiscipline)
+	return &curriculumMatrixDisciplineEntity, nil
+}
+
+func (r *repository) FindCurriculumMatrixDisciplineByDisciplineId(ctx context.Context, curriculumMatrixId, disciplineId int64) (*entity.CurriculumMatrixDisciplineEntity, error) {
+	curriculumMatrixDiscipline, err := r.queries.FindCurriculumMatrixDisciplineByDisciplineId(ctx, sqlc.FindCurriculumMatrixDisciplineByDisciplineIdParams{
+		CurriculumMatrixID: curriculumMatrixId,
+		DisciplineID:       disciplineId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	curriculumMatrixDisciplineEntity := toCurriculumMatrixDisciplineEntity(curriculumMatrixDiscipline)
+	return &curriculumMatrixDisciplineEntity, nil
+}
+
+func (r *repository) FindDisciplineOfCurriculumMatrixCourseByCode(ctx context.Context, curriculumMatrixId int64, code string) (*entity.DisciplineEntity, error) {
+	discipline, err := r.queries.FindDisciplineOfCurriculumMatrixCourseByCode(ctx, sqlc.FindDisciplineOfCurriculumMatrixCourseByCodeParams{
+		CurriculumMatrixID: curriculumMatrixId,
+		Code:               code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	disciplineEntity := entity.DisciplineEntity{
+		ID:                discipline.ID,
+		UUID:              discipline.Uuid,
+		Code:              discipline.Code,
+		Name:              discipline.Name,
+		Credits:           discipline.Credits,
+		CourseID:          discipline.CourseID,
+		RoomType:          discipline.RoomType,
+		ExpectedEnrolment: discipline.ExpectedEnrolment,
+		Term:              discipline.Term,
+	}
+
+	return &disciplineEntity, nil
+}
+
+func (r *repository) IsDisciplineOfCurriculumMatrixCourse(ctx context.Context, curriculumMatrixId, disciplineId int64) (bool, error) {
+	return r.queries.IsDisciplineOfCurriculumMatrixCourse(ctx, sqlc.IsDisciplineOfCurriculumMatrixCourseParams{
+		CurriculumMatrixID: curriculumMatrixId,
+		DisciplineID:       disciplineId,
+	})
+}
+
+func (r *repository) UpdateCurriculumMatrixDiscipline(ctx context.Context, u *entity.CurriculumMatrixDisciplineEntity) error {
+	err := r.queries.UpdateCurriculumMatrixDiscipline(ctx, sqlc.UpdateCurriculumMatrixDisciplineParams{
+		Uuid:      u.UUID,
+		Term:      u.Term,
+		Mandatory: u.Mandatory,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteCurriculumMatrixDiscipline(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteCurriculumMatrixDiscipline(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReplaceCurriculumMatrixDisciplines swaps every discipline of the matrix for the given
+// ones at once, so a failed import leaves the matrix as it was.
+func (r *repository) ReplaceCurriculumMatrixDisciplines(ctx context.Context, curriculumMatrixId int64, disciplines []entity.CurriculumMatrixDisciplineEntity) error {
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		if err := q.DeleteCurriculumMatrixDisciplinesByCurriculumMatrixId(ctx, curriculumMatrixId); err != nil {
+			return err
+		}
+
+		for _, discipline := range disciplines {
+			err := q.CreateCurriculumMatrixDiscipline(ctx, sqlc.CreateCurriculumMatrixDisciplineParams{
+				Uuid:               discipline.UUID,
+				CurriculumMatrixID: curriculumMatrixId,
+				DisciplineID:       discipline.DisciplineID,
+				Term:               discipline.Term,
+				Mandatory:          discipline.Mandatory,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *repository) FindManyCurriculumMatrixDisciplinesByCurriculumMatrixId(ctx context.Context, curriculumMatrixId int64) ([]entity.CurriculumMatrixDisciplineEntity, error) {
+	rows, err := r.queries.FindManyCurriculumMatrixDisciplinesByCurriculumMatrixId(ctx, curriculumMatrixId)
+	if err != nil {
+		return nil, err
+	}
+
+	var curriculumMatrixDisciplines []entity.CurriculumMatrixDisciplineEntity
+	for _, row := range rows {
+		curriculumMatrixDisciplines = append(curriculumMatrixDisciplines, toCurriculumMatrixDisciplineRowEntity(sqlc.FindActiveCurriculumMatrixDisciplinesByCourseIdRow(row)))
+	}
+	return curriculumMatrixDisciplines, nil
+}
+
+// FindActiveCurriculumMatrixDisciplinesByCourseId lists the disciplines of the active
+// matrix version of the course, each carrying the term the matrix places it in.
+func (r *repository) FindActiveCurriculumMatrixDisciplinesByCourseId(ctx context.Context, courseId int64) ([]entity.CurriculumMatrixDisciplineEntity, error) {
+	rows, err := r.queries.FindActiveCurriculumMatrixDisciplinesByCourseId(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	var curriculumMatrixDisciplines []entity.CurriculumMatrixDisciplineEntity
+	for _, row := range rows {
+		curriculumMatrixDisciplines = append(curriculumMatrixDisciplines, toCurriculumMatrixDisciplineRowEntity(row))
+	}
+	return curriculumMatrixDisciplines, nil
+}
+
+func toCurriculumMatrixEntity(cm sqlc.CurriculumMatrix) entity.CurriculumMatrixEntity {
+	return entity.CurriculumMatrixEntity{
+		ID:       cm.ID,
+		UUID:     cm.Uuid,
+		CourseID: cm.CourseID,
+		Version:  cm.Version,
+		Active:   cm.Active,
+	}
+}
+
+func toCurriculumMatrixDisciplineEntity(cmd sqlc.CurriculumMatrixDiscipline) entity.CurriculumMatrixDisciplineEntity {
+	return entity.CurriculumMatrixDisciplineEntity{
+		ID:                 cmd.ID,
+		UUID:               cmd.Uuid,
+		CurriculumMatrixID: cmd.CurriculumMatrixID,
+		DisciplineID:       cmd.DisciplineID,
+		Term:               cmd.Term,
+		Mandatory:          cmd.Mandatory,
+	}
+}
+
+func toCurriculumMatrixDisciplineRowEntity(row sqlc.FindActiveCurriculumMatrixDisciplinesByCourseIdRow) entity.CurriculumMatrixDisciplineEntity {
+	return entity.CurriculumMatrixDisciplineEntity{
+		ID:                 row.ID,
+		UUID:               row.Uuid,
+		CurriculumMatrixID: row.CurriculumMatrixID,
+		DisciplineID:       row.DisciplineID,
+		Term:               row.Term,
+		Mandatory:          row.Mandatory,
+		Discipline: &entity.DisciplineEntity{
+			ID:                row.DisciplineID,
+			UUID:              row.DisciplineUuid,
+			Code:              row.DisciplineCode,
+			Name:              row.DisciplineName,
+			Credits:           row.DisciplineCredits,
+			CourseID:          row.DisciplineCourseID,
+			RoomType:          row.DisciplineRoomType,
+			ExpectedEnrolment: row.DisciplineExpectedEnrolment,
+			Term:              row.Term,
+		},
+	}
+}
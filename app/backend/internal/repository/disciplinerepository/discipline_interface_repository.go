@@ -23,6 +23,7 @@ type repository struct {
 type DisciplineRepository interface {
 	CreateDiscipline(ctx context.Context, u *entity.DisciplineEntity) error
 	FindDisciplineByID(ctx context.Context, uuid uuid.UUID) (*entity.DisciplineEntity, error)
+	FindDisciplineByCode(ctx context.Context, code string) (*entity.DisciplineEntity, error)
 	UpdateDiscipline(ctx context.Context, u *entity.DisciplineEntity) error
 	DeleteDiscipline(ctx context.Context, uuid uuid.UUID) error
 	FindManyDisciplines(ctx context.Context) ([]entity.DisciplineEntity, error)
@@ -10,10 +10,14 @@ import (
 
 func (r *repository) CreateDiscipline(ctx context.Context, u *entity.DisciplineEntity) error {
 	err := r.queries.CreateDiscipline(ctx, sqlc.CreateDisciplineParams{
-		Uuid:     u.UUID,
-		Name:     u.Name,
-		Credits:  u.Credits,
-		CourseID: u.CourseID,
+		Uuid:              u.UUID,
+		Name:              u.Name,
+		Credits:           u.Credits,
+		CourseID:          u.CourseID,
+		RoomType:          u.RoomType,
+		ExpectedEnrolment: u.ExpectedEnrolment,
+		Term:              u.Term,
+		Code:              u.Code,
 	})
 	if err != nil {
 		return err
@@ -29,10 +33,35 @@ func (r *repository) FindDisciplineByID(ctx context.Context, uuid uuid.UUID) (*e
 	}
 
 	disciplineEntity := entity.DisciplineEntity{
-		UUID:     discipline.Uuid,
-		Name:     discipline.Name,
-		Credits:  discipline.Credits,
-		CourseID: discipline.CourseID,
+		UUID:              discipline.Uuid,
+		Name:              discipline.Name,
+		Credits:           discipline.Credits,
+		CourseID:          discipline.CourseID,
+		RoomType:          discipline.RoomType,
+		ExpectedEnrolment: discipline.ExpectedEnrolment,
+		Term:              discipline.Term,
+		Code:              discipline.Code,
+	}
+
+	return &disciplineEntity, nil
+}
+
+func (r *repository) FindDisciplineByCode(ctx context.Context, code string) (*entity.DisciplineEntity, error) {
+	discipline, err := r.queries.FindDisciplineByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	disciplineEntity := entity.DisciplineEntity{
+		ID:                discipline.ID,
+		UUID:              discipline.Uuid,
+		Name:              discipline.Name,
+		Credits:           discipline.Credits,
+		CourseID:          discipline.CourseID,
+		RoomType:          discipline.RoomType,
+		ExpectedEnrolment: discipline.ExpectedEnrolment,
+		Term:              discipline.Term,
+		Code:              discipline.Code,
 	}
 
 	return &disciplineEntity, nil
@@ -40,9 +69,13 @@ func (r *repository) FindDisciplineByID(ctx context.Context, uuid uuid.UUID) (*e
 
 func (r *repository) UpdateDiscipline(ctx context.Context, u *entity.DisciplineEntity) error {
 	err := r.queries.UpdateDiscipline(ctx, sqlc.UpdateDisciplineParams{
-		Uuid:    u.UUID,
-		Name:    sql.NullString{String: u.Name, Valid: u.Name != ""},
-		Credits: sql.NullInt32{Int32: u.Credits, Valid: u.Credits != 0},
+		Uuid:              u.UUID,
+		Name:              sql.NullString{String: u.Name, Valid: u.Name != ""},
+		Credits:           sql.NullInt32{Int32: u.Credits, Valid: u.Credits != 0},
+		RoomType:          sql.NullString{String: u.RoomType, Valid: u.RoomType != ""},
+		ExpectedEnrolment: sql.NullInt32{Int32: u.ExpectedEnrolment, Valid: u.ExpectedEnrolment != 0},
+		Term:              sql.NullInt32{Int32: u.Term, Valid: true},
+		Code:              sql.NullString{String: u.Code, Valid: u.Code != ""},
 	})
 
 	if err != nil {
@@ -71,11 +104,15 @@ func (r *repository) FindManyDisciplines(ctx context.Context) ([]entity.Discipli
 	var disciplinesEntity []entity.DisciplineEntity
 	for _, discipline := range disciplines {
 		disciplineEntity := entity.DisciplineEntity{
-			ID:       discipline.ID,
-			UUID:     discipline.Uuid,
-			Name:     discipline.Name,
-			Credits:  discipline.Credits,
-			CourseID: discipline.CourseID,
+			ID:                discipline.ID,
+			UUID:              discipline.Uuid,
+			Name:              discipline.Name,
+			Credits:           discipline.Credits,
+			CourseID:          discipline.CourseID,
+			RoomType:          discipline.RoomType,
+			ExpectedEnrolment: discipline.ExpectedEnrolment,
+			Term:              discipline.Term,
+			Code:              discipline.Code,
 		}
 
 		disciplinesEntity = append(disciplinesEntity, disciplineEntity)
@@ -92,11 +129,15 @@ func (r *repository) FindManyDisciplinesByCoarseId(ctx context.Context, courseId
 	var disciplinesEntity []entity.DisciplineEntity
 	for _, discipline := range disciplines {
 		disciplineEntity := entity.DisciplineEntity{
-			ID:       discipline.ID,
-			UUID:     discipline.Uuid,
-			Name:     discipline.Name,
-			Credits:  discipline.Credits,
-			CourseID: discipline.CourseID,
+			ID:                discipline.ID,
+			UUID:              discipline.Uuid,
+			Name:              discipline.Name,
+			Credits:           discipline.Credits,
+			CourseID:          discipline.CourseID,
+			RoomType:          discipline.RoomType,
+			ExpectedEnrolment: discipline.ExpectedEnrolment,
+			Term:              discipline.Term,
+			Code:              discipline.Code,
 		}
 
 		disciplinesEntity = append(disciplinesEntity, disciplineEntity)
@@ -0,0 +1,31 @@
+package lockedclassrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewLockedClassRepository(db *sql.DB, q *sqlc.Queries) LockedClassRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type LockedClassRepository interface {
+	CreateLockedClass(ctx context.Context, u *entity.LockedClassEntity) error
+	CreateLockedClasses(ctx context.Context, lockedClasses []entity.LockedClassEntity) error
+	FindLockedClassByID(ctx context.Context, uuid uuid.UUID) (*entity.LockedClassEntity, error)
+	IsDisciplineOfParameterizationCourse(ctx context.Context, parameterizationId, disciplineId int64) (bool, error)
+	UpdateLockedClass(ctx context.Context, u *entity.LockedClassEntity) error
+	DeleteLockedClass(ctx context.Context, uuid uuid.UUID) error
+	FindManyLockedClassesByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.LockedClassEntity, error)
+}
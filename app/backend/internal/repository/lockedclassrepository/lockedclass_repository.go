@@ -0,0 +1,117 @@
+package lockedclassrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/repository/transaction"
+)
+
+func (r *repository) CreateLockedClass(ctx context.Context, u *entity.LockedClassEntity) error {
+	return createLockedClass(ctx, r.queries, u)
+}
+
+// CreateLockedClasses locks all the classes in one transaction, so either all of them
+// are locked or none is.
+func (r *repository) CreateLockedClasses(ctx context.Context, lockedClasses []entity.LockedClassEntity) error {
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		for _, lockedClass := range lockedClasses {
+			if err := createLockedClass(ctx, q, &lockedClass); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func createLockedClass(ctx context.Context, q *sqlc.Queries, u *entity.LockedClassEntity) error {
+	err := q.CreateLockedClass(ctx, sqlc.CreateLockedClassParams{
+		Uuid:               u.UUID,
+		ParameterizationID: u.ParameterizationID,
+		DisciplineID:       u.DisciplineID,
+		ProfessorID:        u.ProfessorID,
+		RoomID:             sql.NullInt64{Int64: u.RoomID, Valid: u.RoomID != 0},
+		Section:            u.Section,
+		DayOfWeek:          u.DayOfWeek,
+		StartTime:          u.StartTime,
+		EndTime:            u.EndTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindLockedClassByID(ctx context.Context, uuid uuid.UUID) (*entity.LockedClassEntity, error) {
+	lockedClass, err := r.queries.FindLockedClassByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedClassEntity := toLockedClassEntity(lockedClass)
+	return &lockedClassEntity, nil
+}
+
+func (r *repository) IsDisciplineOfParameterizationCourse(ctx context.Context, parameterizationId, disciplineId int64) (bool, error) {
+	return r.queries.IsDisciplineOfParameterizationCourse(ctx, sqlc.IsDisciplineOfParameterizationCourseParams{
+		ParameterizationID: parameterizationId,
+		DisciplineID:       disciplineId,
+	})
+}
+
+func (r *repository) UpdateLockedClass(ctx context.Context, u *entity.LockedClassEntity) error {
+	err := r.queries.UpdateLockedClass(ctx, sqlc.UpdateLockedClassParams{
+		Uuid:        u.UUID,
+		ProfessorID: u.ProfessorID,
+		RoomID:      sql.NullInt64{Int64: u.RoomID, Valid: u.RoomID != 0},
+		Section:     u.Section,
+		DayOfWeek:   u.DayOfWeek,
+		StartTime:   u.StartTime,
+		EndTime:     u.EndTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteLockedClass(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteLockedClass(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManyLockedClassesByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.LockedClassEntity, error) {
+	lockedClasses, err := r.queries.FindManyLockedClassesByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockedClassesEntity []entity.LockedClassEntity
+	for _, lockedClass := range lockedClasses {
+		lockedClassesEntity = append(lockedClassesEntity, toLockedClassEntity(lockedClass))
+	}
+	return lockedClassesEntity, nil
+}
+
+func toLockedClassEntity(lc sqlc.LockedClass) entity.LockedClassEntity {
+	return entity.LockedClassEntity{
+		ID:                 lc.ID,
+		UUID:               lc.Uuid,
+		ParameterizationID: lc.ParameterizationID,
+		DisciplineID:       lc.DisciplineID,
+		ProfessorID:        lc.ProfessorID,
+		RoomID:             lc.RoomID.Int64,
+		Section:            lc.Section,
+		DayOfWeek:          lc.DayOfWeek,
+		StartTime:          lc.StartTime,
+		EndTime:            lc.EndTime,
+	}
+}
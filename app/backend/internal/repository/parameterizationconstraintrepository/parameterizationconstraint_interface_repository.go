@@ -0,0 +1,30 @@
+package parameterizationconstraintrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewParameterizationConstraintRepository(db *sql.DB, q *sqlc.Queries) ParameterizationConstraintRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type ParameterizationConstraintRepository interface {
+	CreateParameterizationConstraint(ctx context.Context, u *entity.ParameterizationConstraintEntity) error
+	FindParameterizationConstraintByID(ctx context.Context, uuid uuid.UUID) (*entity.ParameterizationConstraintEntity, error)
+	FindParameterizationConstraintByName(ctx context.Context, parameterizationId int64, name string) (*entity.ParameterizationConstraintEntity, error)
+	UpdateParameterizationConstraint(ctx context.Context, u *entity.ParameterizationConstraintEntity) error
+	DeleteParameterizationConstraint(ctx context.Context, uuid uuid.UUID) error
+	FindManyParameterizationConstraintsByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.ParameterizationConstraintEntity, error)
+}
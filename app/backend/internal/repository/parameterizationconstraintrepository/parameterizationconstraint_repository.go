@@ -0,0 +1,92 @@
+package parameterizationconstraintrepository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func (r *repository) CreateParameterizationConstraint(ctx context.Context, u *entity.ParameterizationConstraintEntity) error {
+	err := r.queries.CreateParameterizationConstraint(ctx, sqlc.CreateParameterizationConstraintParams{
+		Uuid:               u.UUID,
+		ParameterizationID: u.ParameterizationID,
+		Name:               u.Name,
+		Enabled:            u.Enabled,
+		Weight:             u.Weight,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindParameterizationConstraintByID(ctx context.Context, uuid uuid.UUID) (*entity.ParameterizationConstraintEntity, error) {
+	parameterizationConstraint, err := r.queries.FindParameterizationConstraintByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	parameterizationConstraintEntity := toParameterizationConstraintEntity(parameterizationConstraint)
+	return &parameterizationConstraintEntity, nil
+}
+
+func (r *repository) FindParameterizationConstraintByName(ctx context.Context, parameterizationId int64, name string) (*entity.ParameterizationConstraintEntity, error) {
+	parameterizationConstraint, err := r.queries.FindParameterizationConstraintByName(ctx, sqlc.FindParameterizationConstraintByNameParams{
+		ParameterizationID: parameterizationId,
+		Name:               name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parameterizationConstraintEntity := toParameterizationConstraintEntity(parameterizationConstraint)
+	return &parameterizationConstraintEntity, nil
+}
+
+func (r *repository) UpdateParameterizationConstraint(ctx context.Context, u *entity.ParameterizationConstraintEntity) error {
+	err := r.queries.UpdateParameterizationConstraint(ctx, sqlc.UpdateParameterizationConstraintParams{
+		Uuid:    u.UUID,
+		Enabled: u.Enabled,
+		Weight:  u.Weight,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteParameterizationConstraint(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteParameterizationConstraint(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManyParameterizationConstraintsByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.ParameterizationConstraintEntity, error) {
+	parameterizationConstraints, err := r.queries.FindManyParameterizationConstraintsByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var parameterizationConstraintsEntity []entity.ParameterizationConstraintEntity
+	for _, parameterizationConstraint := range parameterizationConstraints {
+		parameterizationConstraintsEntity = append(parameterizationConstraintsEntity, toParameterizationConstraintEntity(parameterizationConstraint))
+	}
+	return parameterizationConstraintsEntity, nil
+}
+
+func toParameterizationConstraintEntity(pc sqlc.ParameterizationConstraint) entity.ParameterizationConstraintEntity {
+	return entity.ParameterizationConstraintEntity{
+		ID:                 pc.ID,
+		UUID:               pc.Uuid,
+		ParameterizationID: pc.ParameterizationID,
+		Name:               pc.Name,
+		Enabled:            pc.Enabled,
+		Weight:             pc.Weight,
+	}
+}
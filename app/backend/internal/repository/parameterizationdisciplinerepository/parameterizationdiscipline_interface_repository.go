@@ -0,0 +1,31 @@
+package parameterizationdisciplinerepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewParameterizationDisciplineRepository(db *sql.DB, q *sqlc.Queries) ParameterizationDisciplineRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type ParameterizationDisciplineRepository interface {
+	CreateParameterizationDiscipline(ctx context.Context, u *entity.ParameterizationDisciplineEntity) error
+	FindParameterizationDisciplineByID(ctx context.Context, uuid uuid.UUID) (*entity.ParameterizationDisciplineEntity, error)
+	FindParameterizationDisciplineByDisciplineId(ctx context.Context, parameterizationId, disciplineId int64) (*entity.ParameterizationDisciplineEntity, error)
+	IsDisciplineOfParameterizationCourse(ctx context.Context, parameterizationId, disciplineId int64) (bool, error)
+	UpdateParameterizationDiscipline(ctx context.Context, u *entity.ParameterizationDisciplineEntity) error
+	DeleteParameterizationDiscipline(ctx context.Context, uuid uuid.UUID) error
+	FindManyParameterizationDisciplinesByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.ParameterizationDisciplineEntity, error)
+}
@@ -0,0 +1,99 @@
+package parameterizationdisciplinerepository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func (r *repository) CreateParameterizationDiscipline(ctx context.Context, u *entity.ParameterizationDisciplineEntity) error {
+	err := r.queries.CreateParameterizationDiscipline(ctx, sqlc.CreateParameterizationDisciplineParams{
+		Uuid:               u.UUID,
+		ParameterizationID: u.ParameterizationID,
+		DisciplineID:       u.DisciplineID,
+		Priority:           u.Priority,
+		Mandatory:          u.Mandatory,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindParameterizationDisciplineByID(ctx context.Context, uuid uuid.UUID) (*entity.ParameterizationDisciplineEntity, error) {
+	parameterizationDiscipline, err := r.queries.FindParameterizationDisciplineByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	parameterizationDisciplineEntity := toParameterizationDisciplineEntity(parameterizationDiscipline)
+	return &parameterizationDisciplineEntity, nil
+}
+
+func (r *repository) FindParameterizationDisciplineByDisciplineId(ctx context.Context, parameterizationId, disciplineId int64) (*entity.ParameterizationDisciplineEntity, error) {
+	parameterizationDiscipline, err := r.queries.FindParameterizationDisciplineByDisciplineId(ctx, sqlc.FindParameterizationDisciplineByDisciplineIdParams{
+		ParameterizationID: parameterizationId,
+		DisciplineID:       disciplineId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parameterizationDisciplineEntity := toParameterizationDisciplineEntity(parameterizationDiscipline)
+	return &parameterizationDisciplineEntity, nil
+}
+
+func (r *repository) IsDisciplineOfParameterizationCourse(ctx context.Context, parameterizationId, disciplineId int64) (bool, error) {
+	return r.queries.IsDisciplineOfParameterizationCourse(ctx, sqlc.IsDisciplineOfParameterizationCourseParams{
+		ParameterizationID: parameterizationId,
+		DisciplineID:       disciplineId,
+	})
+}
+
+func (r *repository) UpdateParameterizationDiscipline(ctx context.Context, u *entity.ParameterizationDisciplineEntity) error {
+	err := r.queries.UpdateParameterizationDiscipline(ctx, sqlc.UpdateParameterizationDisciplineParams{
+		Uuid:      u.UUID,
+		Priority:  u.Priority,
+		Mandatory: u.Mandatory,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteParameterizationDiscipline(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteParameterizationDiscipline(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManyParameterizationDisciplinesByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.ParameterizationDisciplineEntity, error) {
+	parameterizationDisciplines, err := r.queries.FindManyParameterizationDisciplinesByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var parameterizationDisciplinesEntity []entity.ParameterizationDisciplineEntity
+	for _, parameterizationDiscipline := range parameterizationDisciplines {
+		parameterizationDisciplinesEntity = append(parameterizationDisciplinesEntity, toParameterizationDisciplineEntity(parameterizationDiscipline))
+	}
+	return parameterizationDisciplinesEntity, nil
+}
+
+func toParameterizationDisciplineEntity(pd sqlc.ParameterizationDiscipline) entity.ParameterizationDisciplineEntity {
+	return entity.ParameterizationDisciplineEntity{
+		ID:                 pd.ID,
+		UUID:               pd.Uuid,
+		ParameterizationID: pd.ParameterizationID,
+		DisciplineID:       pd.DisciplineID,
+		Priority:           pd.Priority,
+		Mandatory:          pd.Mandatory,
+	}
+}
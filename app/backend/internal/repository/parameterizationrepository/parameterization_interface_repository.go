@@ -23,11 +23,11 @@ type repository struct {
 type ParameterizationRepository interface {
 	CreateParameterization(ctx context.Context, u *entity.ParameterizationEntity) error
 	FindParameterizationByID(ctx context.Context, uuid uuid.UUID) (*entity.ParameterizationEntity, error)
+	FindParameterizationByParameterizationId(ctx context.Context, id int64) (*entity.ParameterizationEntity, error)
 	UpdateParameterization(ctx context.Context, u *entity.ParameterizationEntity) error
 	DeleteParameterization(ctx context.Context, uuid uuid.UUID) error
 	FindManyParameterizations(ctx context.Context) ([]entity.ParameterizationEntity, error)
 	FindManyParameterizationsBySemesterId(ctx context.Context, semesterId int64) ([]entity.ParameterizationEntity, error)
 	GetDisciplinesByCourseID(ctx context.Context, courseId int64) ([]entity.DisciplineEntity, error)
 	GetProfessorsByCourseID(ctx context.Context, courseId int64) ([]entity.ProfessorEntity, error)
-	CreateProposal(ctx context.Context, u *entity.ProposalEntity) error
 }
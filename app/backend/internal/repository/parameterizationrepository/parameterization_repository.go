@@ -15,6 +15,15 @@ func (r *repository) CreateParameterization(ctx context.Context, u *entity.Param
 		Numclassesperdiscipline: u.NumClassesPerDiscipline,
 		SemesterID:              u.SemesterID,
 		CourseID:                u.CourseID,
+		Populationsize:          u.PopulationSize,
+		Generations:             u.Generations,
+		Tournamentsize:          u.TournamentSize,
+		Mutationrate:            u.MutationRate,
+		Hourspercredit:          u.HoursPerCredit,
+		Maxblockhours:           u.MaxBlockHours,
+		Spreadweight:            u.SpreadWeight,
+		Samedayweight:           u.SameDayWeight,
+		Idlegapweight:           u.IdleGapWeight,
 	})
 	if err != nil {
 		return err
@@ -30,11 +39,48 @@ func (r *repository) FindParameterizationByID(ctx context.Context, uuid uuid.UUI
 	}
 
 	parameterizationEntity := entity.ParameterizationEntity{
+		ID:                      parameterization.ID,
 		UUID:                    parameterization.Uuid,
 		MaxCreditsToOffer:       parameterization.Maxcreditstooffer,
 		NumClassesPerDiscipline: parameterization.Numclassesperdiscipline,
 		SemesterID:              parameterization.SemesterID,
 		CourseID:                parameterization.CourseID,
+		PopulationSize:          parameterization.Populationsize,
+		Generations:             parameterization.Generations,
+		TournamentSize:          parameterization.Tournamentsize,
+		MutationRate:            parameterization.Mutationrate,
+		HoursPerCredit:          parameterization.Hourspercredit,
+		MaxBlockHours:           parameterization.Maxblockhours,
+		SpreadWeight:            parameterization.Spreadweight,
+		SameDayWeight:           parameterization.Samedayweight,
+		IdleGapWeight:           parameterization.Idlegapweight,
+	}
+
+	return &parameterizationEntity, nil
+}
+
+func (r *repository) FindParameterizationByParameterizationId(ctx context.Context, id int64) (*entity.ParameterizationEntity, error) {
+	parameterization, err := r.queries.FindParameterizationByParameterizationId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	parameterizationEntity := entity.ParameterizationEntity{
+		ID:                      parameterization.ID,
+		UUID:                    parameterization.Uuid,
+		MaxCreditsToOffer:       parameterization.Maxcreditstooffer,
+		NumClassesPerDiscipline: parameterization.Numclassesperdiscipline,
+		SemesterID:              parameterization.SemesterID,
+		CourseID:                parameterization.CourseID,
+		PopulationSize:          parameterization.Populationsize,
+		Generations:             parameterization.Generations,
+		TournamentSize:          parameterization.Tournamentsize,
+		MutationRate:            parameterization.Mutationrate,
+		HoursPerCredit:          parameterization.Hourspercredit,
+		MaxBlockHours:           parameterization.Maxblockhours,
+		SpreadWeight:            parameterization.Spreadweight,
+		SameDayWeight:           parameterization.Samedayweight,
+		IdleGapWeight:           parameterization.Idlegapweight,
 	}
 
 	return &parameterizationEntity, nil
@@ -45,6 +91,15 @@ func (r *repository) UpdateParameterization(ctx context.Context, u *entity.Param
 		Uuid:                    u.UUID,
 		MaxCreditsToOffer:       sql.NullInt32{Int32: u.MaxCreditsToOffer, Valid: u.MaxCreditsToOffer != 0},
 		NumClassesPerDiscipline: sql.NullInt32{Int32: u.NumClassesPerDiscipline, Valid: u.NumClassesPerDiscipline != 0},
+		PopulationSize:          sql.NullInt32{Int32: u.PopulationSize, Valid: u.PopulationSize != 0},
+		Generations:             sql.NullInt32{Int32: u.Generations, Valid: u.Generations != 0},
+		TournamentSize:          sql.NullInt32{Int32: u.TournamentSize, Valid: u.TournamentSize != 0},
+		MutationRate:            sql.NullFloat64{Float64: u.MutationRate, Valid: true},
+		HoursPerCredit:          sql.NullInt32{Int32: u.HoursPerCredit, Valid: u.HoursPerCredit != 0},
+		MaxBlockHours:           sql.NullInt32{Int32: u.MaxBlockHours, Valid: u.MaxBlockHours != 0},
+		SpreadWeight:            sql.NullFloat64{Float64: u.SpreadWeight, Valid: true},
+		SameDayWeight:           sql.NullFloat64{Float64: u.SameDayWeight, Valid: true},
+		IdleGapWeight:           sql.NullFloat64{Float64: u.IdleGapWeight, Valid: true},
 	})
 
 	if err != nil {
@@ -79,6 +134,15 @@ func (r *repository) FindManyParameterizations(ctx context.Context) ([]entity.Pa
 			NumClassesPerDiscipline: parameterization.Numclassesperdiscipline,
 			SemesterID:              parameterization.SemesterID,
 			CourseID:                parameterization.CourseID,
+			PopulationSize:          parameterization.Populationsize,
+			Generations:             parameterization.Generations,
+			TournamentSize:          parameterization.Tournamentsize,
+			MutationRate:            parameterization.Mutationrate,
+			HoursPerCredit:          parameterization.Hourspercredit,
+			MaxBlockHours:           parameterization.Maxblockhours,
+			SpreadWeight:            parameterization.Spreadweight,
+			SameDayWeight:           parameterization.Samedayweight,
+			IdleGapWeight:           parameterization.Idlegapweight,
 		}
 
 		parameterizationsEntity = append(parameterizationsEntity, parameterizationEntity)
@@ -101,6 +165,15 @@ func (r *repository) FindManyParameterizationsBySemesterId(ctx context.Context,
 			NumClassesPerDiscipline: parameterization.Numclassesperdiscipline,
 			SemesterID:              parameterization.SemesterID,
 			CourseID:                parameterization.CourseID,
+			PopulationSize:          parameterization.Populationsize,
+			Generations:             parameterization.Generations,
+			TournamentSize:          parameterization.Tournamentsize,
+			MutationRate:            parameterization.Mutationrate,
+			HoursPerCredit:          parameterization.Hourspercredit,
+			MaxBlockHours:           parameterization.Maxblockhours,
+			SpreadWeight:            parameterization.Spreadweight,
+			SameDayWeight:           parameterization.Samedayweight,
+			IdleGapWeight:           parameterization.Idlegapweight,
 		}
 
 		parameterizationsEntity = append(parameterizationsEntity, parameterizationEntity)
@@ -117,11 +190,15 @@ func (r *repository) GetDisciplinesByCourseID(ctx context.Context, courseID int6
 	var disciplines []entity.DisciplineEntity
 	for _, row := range rows {
 		discipline := entity.DisciplineEntity{
-			ID:       row.ID,
-			UUID:     row.Uuid,
-			Name:     row.Name,
-			Credits:  row.Credits,
-			CourseID: row.CourseID,
+			ID:                row.ID,
+			UUID:              row.Uuid,
+			Name:              row.Name,
+			Credits:           row.Credits,
+			CourseID:          row.CourseID,
+			RoomType:          row.RoomType,
+			ExpectedEnrolment: row.ExpectedEnrolment,
+			Term:              row.Term,
+			Code:              row.Code,
 		}
 		disciplines = append(disciplines, discipline)
 	}
@@ -148,43 +225,3 @@ func (r *repository) GetProfessorsByCourseID(ctx context.Context, courseID int64
 
 	return professors, nil
 }
-
-func (r *repository) CreateProposal(ctx context.Context, u *entity.ProposalEntity) error {
-	if u.Classes == nil || len(u.Classes) == 0 {
-		return nil
-	}
-
-	proposalUUID := uuid.New()
-	err := r.queries.CreateProposal(ctx, sqlc.CreateProposalParams{
-		Uuid:       proposalUUID,
-		SemesterID: u.SemesterID,
-		CourseID:   u.CourseID,
-	})
-	if err != nil {
-		return err
-	}
-
-	proposalID, err := r.queries.GetProposalID(ctx, proposalUUID)
-	if err != nil {
-		return err
-	}
-
-	for _, class := range u.Classes {
-		classUUID := uuid.New()
-		err := r.queries.CreateClass(ctx, sqlc.CreateClassParams{
-			Uuid:         classUUID,
-			Dayofweek:    class.DayOfWeek,
-			Shift:        class.Shift,
-			Starttime:    class.StartTime,
-			Endtime:      class.EndTime,
-			DisciplineID: class.DisciplineID,
-			ProfessorID:  class.ProfessorID,
-			ProposalID:   proposalID,
-		})
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
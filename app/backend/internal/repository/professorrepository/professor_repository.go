@@ -28,6 +28,7 @@ func (r *repository) FindProfessorByID(ctx context.Context, uuid uuid.UUID) (*en
 	}
 
 	professorEntity := entity.ProfessorEntity{
+		ID:              professor.ID,
 		UUID:            professor.Uuid,
 		Name:            professor.Name,
 		HoursToAllocate: professor.Hourstoallocate,
@@ -86,9 +87,12 @@ func (r *repository) GetProfessorsWithDisciplines(ctx context.Context) ([]entity
 		return nil, err
 	}
 
+	// keep the query order so the genetic algorithm always sees the professors the same way
 	professorMap := make(map[int64]*entity.ProfessorEntity)
+	var professorIDs []int64
 	for _, row := range rows {
 		if _, exists := professorMap[row.ProfessorID]; !exists {
+			professorIDs = append(professorIDs, row.ProfessorID)
 			professorMap[row.ProfessorID] = &entity.ProfessorEntity{
 				ID:              row.ProfessorID,
 				UUID:            row.ProfessorUuid,
@@ -111,8 +115,8 @@ func (r *repository) GetProfessorsWithDisciplines(ctx context.Context) ([]entity
 	}
 
 	var professors []entity.ProfessorEntity
-	for _, professor := range professorMap {
-		professors = append(professors, *professor)
+	for _, professorID := range professorIDs {
+		professors = append(professors, *professorMap[professorID])
 	}
 
 	return professors, nil
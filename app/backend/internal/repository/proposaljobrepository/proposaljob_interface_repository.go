@@ -0,0 +1,32 @@
+package proposaljobrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewProposalJobRepository(db *sql.DB, q *sqlc.Queries) ProposalJobRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type ProposalJobRepository interface {
+	CreateProposalJob(ctx context.Context, u *entity.ProposalJobEntity) error
+	FindProposalJobByID(ctx context.Context, uuid uuid.UUID) (*entity.ProposalJobEntity, error)
+	FindManyProposalJobs(ctx context.Context) ([]entity.ProposalJobEntity, error)
+	FindManyProposalJobsByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.ProposalJobEntity, error)
+	FindManyProposalJobsByStatus(ctx context.Context, status string) ([]entity.ProposalJobEntity, error)
+	ClaimProposalJob(ctx context.Context) (*entity.ProposalJobEntity, error)
+	UpdateProposalJobProgress(ctx context.Context, uuid uuid.UUID, progress int32) error
+	FinishProposalJob(ctx context.Context, u *entity.ProposalJobEntity) error
+}
@@ -0,0 +1,133 @@
+package proposaljobrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func (r *repository) CreateProposalJob(ctx context.Context, u *entity.ProposalJobEntity) error {
+	err := r.queries.CreateProposalJob(ctx, sqlc.CreateProposalJobParams{
+		Uuid:               u.UUID,
+		Status:             u.Status,
+		Seed:               sql.NullInt64{Int64: u.Seed, Valid: true},
+		ParameterizationID: u.ParameterizationID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindProposalJobByID(ctx context.Context, uuid uuid.UUID) (*entity.ProposalJobEntity, error) {
+	job, err := r.queries.FindProposalJobByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	jobEntity := toProposalJobEntity(sqlc.FindManyProposalJobsRow(job))
+
+	return &jobEntity, nil
+}
+
+func (r *repository) FindManyProposalJobs(ctx context.Context) ([]entity.ProposalJobEntity, error) {
+	jobs, err := r.queries.FindManyProposalJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobsEntity []entity.ProposalJobEntity
+	for _, job := range jobs {
+		jobsEntity = append(jobsEntity, toProposalJobEntity(job))
+	}
+	return jobsEntity, nil
+}
+
+func (r *repository) FindManyProposalJobsByParameterizationId(ctx context.Context, parameterizationId int64) ([]entity.ProposalJobEntity, error) {
+	jobs, err := r.queries.FindManyProposalJobsByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobsEntity []entity.ProposalJobEntity
+	for _, job := range jobs {
+		jobsEntity = append(jobsEntity, toProposalJobEntity(sqlc.FindManyProposalJobsRow(job)))
+	}
+	return jobsEntity, nil
+}
+
+func (r *repository) FindManyProposalJobsByStatus(ctx context.Context, status string) ([]entity.ProposalJobEntity, error) {
+	jobs, err := r.queries.FindManyProposalJobsByStatus(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobsEntity []entity.ProposalJobEntity
+	for _, job := range jobs {
+		jobsEntity = append(jobsEntity, toProposalJobEntity(sqlc.FindManyProposalJobsRow(job)))
+	}
+	return jobsEntity, nil
+}
+
+// ClaimProposalJob starts the oldest pending job and returns it. A job is only claimed
+// once however many workers ask at the same time; sql.ErrNoRows means none is pending.
+func (r *repository) ClaimProposalJob(ctx context.Context) (*entity.ProposalJobEntity, error) {
+	job, err := r.queries.ClaimProposalJob(ctx, sqlc.ClaimProposalJobParams{
+		Status:        entity.ProposalJobStatusRunning,
+		PendingStatus: entity.ProposalJobStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jobEntity := toProposalJobEntity(sqlc.FindManyProposalJobsRow(job))
+
+	return &jobEntity, nil
+}
+
+func (r *repository) UpdateProposalJobProgress(ctx context.Context, uuid uuid.UUID, progress int32) error {
+	err := r.queries.UpdateProposalJobProgress(ctx, sqlc.UpdateProposalJobProgressParams{
+		Uuid:     uuid,
+		Progress: progress,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FinishProposalJob(ctx context.Context, u *entity.ProposalJobEntity) error {
+	err := r.queries.FinishProposalJob(ctx, sqlc.FinishProposalJobParams{
+		Uuid:       u.UUID,
+		Status:     u.Status,
+		Progress:   u.Progress,
+		Error:      sql.NullString{String: u.Error, Valid: u.Error != ""},
+		ProposalID: sql.NullInt64{Int64: u.ProposalID, Valid: u.ProposalID != 0},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func toProposalJobEntity(job sqlc.FindManyProposalJobsRow) entity.ProposalJobEntity {
+	return entity.ProposalJobEntity{
+		ID:                   job.ID,
+		UUID:                 job.Uuid,
+		Status:               job.Status,
+		Progress:             job.Progress,
+		Error:                job.Error.String,
+		CreatedAt:            job.CreatedAt,
+		StartedAt:            job.StartedAt.Time,
+		FinishedAt:           job.FinishedAt.Time,
+		Seed:                 job.Seed.Int64,
+		ParameterizationID:   job.ParameterizationID,
+		ParameterizationUUID: job.ParameterizationUuid,
+		ProposalUUID:         job.ProposalUuid.UUID,
+	}
+}
@@ -0,0 +1,43 @@
+package proposalrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewProposalRepository(db *sql.DB, q *sqlc.Queries) ProposalRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type ProposalRepository interface {
+	FindProposalByID(ctx context.Context, uuid uuid.UUID) (*entity.ProposalEntity, error)
+	FindManyProposals(ctx context.Context, semesterId, courseId int64, status string) ([]entity.ProposalEntity, error)
+	FindClassesByProposalID(ctx context.Context, proposalId int64) ([]entity.ClassEntity, error)
+	FindProposalSummaryByID(ctx context.Context, uuid uuid.UUID) (*entity.ProposalSummaryEntity, error)
+	FindLatestProposalSummariesBySemesterId(ctx context.Context, semesterId int64) ([]entity.ProposalSummaryEntity, error)
+	FindProfessorWorkloadsByProposalIds(ctx context.Context, proposalIds []int64) ([]entity.ProfessorWorkloadEntity, error)
+	CreateProposalClass(ctx context.Context, class *entity.ClassEntity, proposal *entity.ProposalEntity, version *entity.ProposalVersionEntity) error
+	UpdateProposalClass(ctx context.Context, class *entity.ClassEntity, proposal *entity.ProposalEntity, version *entity.ProposalVersionEntity) error
+	DeleteProposalClass(ctx context.Context, uuid uuid.UUID, proposal *entity.ProposalEntity, version *entity.ProposalVersionEntity) error
+	TransitionProposal(ctx context.Context, proposal *entity.ProposalEntity, transition *entity.ProposalTransitionEntity) error
+	FindApprovedProposalsByCourseAndSemester(ctx context.Context, courseId, semesterId int64) ([]uuid.UUID, error)
+	FindManyProposalTransitionsByProposalId(ctx context.Context, proposalId int64) ([]entity.ProposalTransitionEntity, error)
+	CreateProposal(ctx context.Context, u *entity.ProposalEntity, version *entity.ProposalVersionEntity) error
+	FindManyProposalVersionsByProposalId(ctx context.Context, proposalId int64) ([]entity.ProposalVersionEntity, error)
+	FindProposalVersion(ctx context.Context, proposalId int64, version int32) (*entity.ProposalVersionEntity, error)
+	FindClassesByVersionId(ctx context.Context, versionId int64) ([]entity.ClassEntity, error)
+	RestoreProposalVersion(ctx context.Context, restored, version *entity.ProposalVersionEntity) error
+	FindApprovedProposalSummariesBySemesterId(ctx context.Context, semesterId int64) ([]entity.ProposalSummaryEntity, error)
+	FindClassesByProfessorAndProposalIds(ctx context.Context, professorId int64, proposalIds []int64) ([]entity.ClassEntity, error)
+}
@@ -0,0 +1,511 @@
+package proposalrepository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/repository/transaction"
+)
+
+func (r *repository) FindProposalByID(ctx context.Context, uuid uuid.UUID) (*entity.ProposalEntity, error) {
+	proposal, err := r.queries.FindProposalByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalEntity, err := toProposalEntity(proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proposalEntity, nil
+}
+
+func (r *repository) FindManyProposals(ctx context.Context, semesterId, courseId int64, status string) ([]entity.ProposalEntity, error) {
+	proposals, err := r.queries.FindManyProposals(ctx, sqlc.FindManyProposalsParams{
+		SemesterID: sql.NullInt64{Int64: semesterId, Valid: semesterId != 0},
+		CourseID:   sql.NullInt64{Int64: courseId, Valid: courseId != 0},
+		Status:     sql.NullString{String: status, Valid: status != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var proposalsEntity []entity.ProposalEntity
+	for _, proposal := range proposals {
+		proposalEntity, err := toProposalEntity(proposal)
+		if err != nil {
+			return nil, err
+		}
+		proposalsEntity = append(proposalsEntity, proposalEntity)
+	}
+	return proposalsEntity, nil
+}
+
+func (r *repository) FindClassesByProposalID(ctx context.Context, proposalId int64) ([]entity.ClassEntity, error) {
+	classes, err := r.queries.FindClassesByProposalID(ctx, proposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	var classesEntity []entity.ClassEntity
+	for _, class := range classes {
+		classesEntity = append(classesEntity, toClassEntity(class))
+	}
+	return classesEntity, nil
+}
+
+func (r *repository) FindProposalSummaryByID(ctx context.Context, uuid uuid.UUID) (*entity.ProposalSummaryEntity, error) {
+	summary, err := r.queries.FindProposalSummaryByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	summaryEntity := toProposalSummaryEntity(sqlc.FindLatestProposalSummariesBySemesterIdRow(summary))
+
+	return &summaryEntity, nil
+}
+
+// FindLatestProposalSummariesBySemesterId returns a proposal of every course in the
+// semester: the latest approved or published one or, when the course has none, the latest.
+func (r *repository) FindLatestProposalSummariesBySemesterId(ctx context.Context, semesterId int64) ([]entity.ProposalSummaryEntity, error) {
+	summaries, err := r.queries.FindLatestProposalSummariesBySemesterId(ctx, semesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	var summariesEntity []entity.ProposalSummaryEntity
+	for _, summary := range summaries {
+		summariesEntity = append(summariesEntity, toProposalSummaryEntity(summary))
+	}
+	return summariesEntity, nil
+}
+
+func (r *repository) FindProfessorWorkloadsByProposalIds(ctx context.Context, proposalIds []int64) ([]entity.ProfessorWorkloadEntity, error) {
+	workloads, err := r.queries.FindProfessorWorkloadsByProposalIds(ctx, proposalIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var workloadsEntity []entity.ProfessorWorkloadEntity
+	for _, workload := range workloads {
+		workloadsEntity = append(workloadsEntity, entity.ProfessorWorkloadEntity{
+			ProfessorID:   workload.ID,
+			ProfessorUUID: workload.Uuid,
+			Name:          workload.Name,
+			PlannedHours:  workload.Hourstoallocate,
+			Classes:       workload.Classes,
+		})
+	}
+	return workloadsEntity, nil
+}
+
+// CreateProposalClass adds the class to the proposal, saving the new fitness of the
+// proposal and the version the edit makes in the same transaction.
+func (r *repository) CreateProposalClass(ctx context.Context, class *entity.ClassEntity, proposal *entity.ProposalEntity, version *entity.ProposalVersionEntity) error {
+	return r.saveProposalEdit(ctx, proposal, version, func(q *sqlc.Queries) error {
+		return q.CreateClass(ctx, sqlc.CreateClassParams{
+			Uuid:         class.UUID,
+			Dayofweek:    class.DayOfWeek,
+			Shift:        class.Shift,
+			Starttime:    class.StartTime,
+			Endtime:      class.EndTime,
+			DisciplineID: class.DisciplineID,
+			ProfessorID:  class.ProfessorID,
+			ProposalID:   class.ProposalID,
+			Section:      class.Section,
+			RoomID:       sql.NullInt64{Int64: class.RoomID, Valid: class.RoomID != 0},
+			Locked:       class.Locked,
+		})
+	})
+}
+
+// UpdateProposalClass saves the class, the new fitness of the proposal and the version
+// the edit makes in the same transaction.
+func (r *repository) UpdateProposalClass(ctx context.Context, class *entity.ClassEntity, proposal *entity.ProposalEntity, version *entity.ProposalVersionEntity) error {
+	return r.saveProposalEdit(ctx, proposal, version, func(q *sqlc.Queries) error {
+		return q.UpdateClass(ctx, sqlc.UpdateClassParams{
+			Uuid:        class.UUID,
+			Dayofweek:   class.DayOfWeek,
+			Shift:       class.Shift,
+			Starttime:   class.StartTime,
+			Endtime:     class.EndTime,
+			ProfessorID: class.ProfessorID,
+			RoomID:      sql.NullInt64{Int64: class.RoomID, Valid: class.RoomID != 0},
+		})
+	})
+}
+
+// DeleteProposalClass removes the class from the proposal, saving the new fitness of the
+// proposal and the version the edit makes in the same transaction.
+func (r *repository) DeleteProposalClass(ctx context.Context, uuid uuid.UUID, proposal *entity.ProposalEntity, version *entity.ProposalVersionEntity) error {
+	return r.saveProposalEdit(ctx, proposal, version, func(q *sqlc.Queries) error {
+		return q.DeleteClass(ctx, uuid)
+	})
+}
+
+// TransitionProposal saves the new status of the proposal together with the transition
+// recording it.
+func (r *repository) TransitionProposal(ctx context.Context, proposal *entity.ProposalEntity, transition *entity.ProposalTransitionEntity) error {
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		err := q.UpdateProposalStatus(ctx, sqlc.UpdateProposalStatusParams{
+			ID:     proposal.ID,
+			Status: proposal.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.CreateProposalTransition(ctx, sqlc.CreateProposalTransitionParams{
+			Uuid:       transition.UUID,
+			ProposalID: transition.ProposalID,
+			FromStatus: transition.FromStatus,
+			ToStatus:   transition.ToStatus,
+			UserUuid:   transition.UserUUID,
+			Comment:    sql.NullString{String: transition.Comment, Valid: transition.Comment != ""},
+		})
+	})
+}
+
+func (r *repository) FindApprovedProposalsByCourseAndSemester(ctx context.Context, courseId, semesterId int64) ([]uuid.UUID, error) {
+	return r.queries.FindApprovedProposalsByCourseAndSemester(ctx, sqlc.FindApprovedProposalsByCourseAndSemesterParams{
+		CourseID:   courseId,
+		SemesterID: semesterId,
+	})
+}
+
+func (r *repository) FindManyProposalTransitionsByProposalId(ctx context.Context, proposalId int64) ([]entity.ProposalTransitionEntity, error) {
+	transitions, err := r.queries.FindManyProposalTransitionsByProposalId(ctx, proposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	var transitionsEntity []entity.ProposalTransitionEntity
+	for _, transition := range transitions {
+		transitionsEntity = append(transitionsEntity, entity.ProposalTransitionEntity{
+			ID:         transition.ID,
+			UUID:       transition.Uuid,
+			ProposalID: transition.ProposalID,
+			FromStatus: transition.FromStatus,
+			ToStatus:   transition.ToStatus,
+			UserUUID:   transition.UserUuid.UUID,
+			UserName:   transition.UserName.String,
+			Comment:    transition.Comment.String,
+			CreatedAt:  transition.CreatedAt,
+		})
+	}
+	return transitionsEntity, nil
+}
+
+func toClassEntity(class sqlc.FindClassesByProposalIDRow) entity.ClassEntity {
+	classEntity := entity.ClassEntity{
+		ID:           class.ID,
+		UUID:         class.Uuid,
+		DayOfWeek:    class.Dayofweek,
+		Shift:        class.Shift,
+		StartTime:    class.Starttime,
+		EndTime:      class.Endtime,
+		Section:      class.Section,
+		DisciplineID: class.DisciplineID,
+		ProfessorID:  class.ProfessorID,
+		ProposalID:   class.ProposalID,
+		RoomID:       class.RoomID.Int64,
+		Locked:       class.Locked,
+		Discipline: &entity.DisciplineEntity{
+			ID:                class.DisciplineID,
+			UUID:              class.DisciplineUuid,
+			Name:              class.DisciplineName,
+			Credits:           class.DisciplineCredits,
+			CourseID:          class.DisciplineCourseID,
+			RoomType:          class.DisciplineRoomType,
+			ExpectedEnrolment: class.DisciplineExpectedEnrolment,
+			Term:              class.DisciplineTerm,
+			Code:              class.DisciplineCode,
+		},
+		Professor: &entity.ProfessorEntity{
+			ID:              class.ProfessorID,
+			UUID:            class.ProfessorUuid,
+			Name:            class.ProfessorName,
+			HoursToAllocate: class.ProfessorHoursToAllocate,
+		},
+	}
+	if class.RoomID.Valid {
+		classEntity.Room = &entity.RoomEntity{
+			ID:       class.RoomID.Int64,
+			UUID:     class.RoomUuid.UUID,
+			Name:     class.RoomName.String,
+			Capacity: class.RoomCapacity.Int32,
+			Type:     class.RoomType.String,
+			Location: class.RoomLocation.String,
+		}
+	}
+	return classEntity
+}
+
+// CreateProposal saves a generated proposal with its classes, which become its first
+// version, all or nothing.
+func (r *repository) CreateProposal(ctx context.Context, u *entity.ProposalEntity, version *entity.ProposalVersionEntity) error {
+	if u.Classes == nil || len(u.Classes) == 0 {
+		return nil
+	}
+
+	breakdown, err := json.Marshal(u.FitnessBreakdown)
+	if err != nil {
+		return err
+	}
+
+	proposalUUID := uuid.New()
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		err := q.CreateProposal(ctx, sqlc.CreateProposalParams{
+			Uuid:               proposalUUID,
+			SemesterID:         u.SemesterID,
+			CourseID:           u.CourseID,
+			Seed:               sql.NullInt64{Int64: u.Seed, Valid: true},
+			Fitness:            sql.NullFloat64{Float64: u.Fitness, Valid: true},
+			ParameterizationID: sql.NullInt64{Int64: u.ParameterizationID, Valid: u.ParameterizationID != 0},
+			PopulationSize:     sql.NullInt32{Int32: u.PopulationSize, Valid: u.PopulationSize != 0},
+			Generations:        sql.NullInt32{Int32: u.Generations, Valid: u.Generations != 0},
+			TournamentSize:     sql.NullInt32{Int32: u.TournamentSize, Valid: u.TournamentSize != 0},
+			MutationRate:       sql.NullFloat64{Float64: u.MutationRate, Valid: u.MutationRate != 0},
+			FitnessBreakdown:   breakdown,
+		})
+		if err != nil {
+			return err
+		}
+
+		proposalID, err := q.GetProposalID(ctx, proposalUUID)
+		if err != nil {
+			return err
+		}
+		u.ID = proposalID
+		u.UUID = proposalUUID
+
+		for _, class := range u.Classes {
+			err := q.CreateClass(ctx, sqlc.CreateClassParams{
+				Uuid:         uuid.New(),
+				Dayofweek:    class.DayOfWeek,
+				Shift:        class.Shift,
+				Starttime:    class.StartTime,
+				Endtime:      class.EndTime,
+				DisciplineID: class.DisciplineID,
+				ProfessorID:  class.ProfessorID,
+				ProposalID:   proposalID,
+				Section:      class.Section,
+				RoomID:       sql.NullInt64{Int64: class.RoomID, Valid: class.RoomID != 0},
+				Locked:       class.Locked,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		version.ProposalID = proposalID
+		return createProposalVersion(ctx, q, version)
+	})
+}
+
+func (r *repository) FindManyProposalVersionsByProposalId(ctx context.Context, proposalId int64) ([]entity.ProposalVersionEntity, error) {
+	versions, err := r.queries.FindManyProposalVersionsByProposalId(ctx, proposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	var versionsEntity []entity.ProposalVersionEntity
+	for _, version := range versions {
+		versionEntity, err := toProposalVersionEntity(sqlc.FindProposalVersionRow(version))
+		if err != nil {
+			return nil, err
+		}
+		versionsEntity = append(versionsEntity, versionEntity)
+	}
+	return versionsEntity, nil
+}
+
+func (r *repository) FindProposalVersion(ctx context.Context, proposalId int64, version int32) (*entity.ProposalVersionEntity, error) {
+	proposalVersion, err := r.queries.FindProposalVersion(ctx, sqlc.FindProposalVersionParams{
+		ProposalID: proposalId,
+		Version:    version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versionEntity, err := toProposalVersionEntity(proposalVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &versionEntity, nil
+}
+
+func (r *repository) FindClassesByVersionId(ctx context.Context, versionId int64) ([]entity.ClassEntity, error) {
+	classes, err := r.queries.FindClassesByVersionId(ctx, sql.NullInt64{Int64: versionId, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var classesEntity []entity.ClassEntity
+	for _, class := range classes {
+		classesEntity = append(classesEntity, toClassEntity(sqlc.FindClassesByProposalIDRow(class)))
+	}
+	return classesEntity, nil
+}
+
+// RestoreProposalVersion replaces the current classes of the proposal with the ones of version and
+// records the result as the new version restored.
+func (r *repository) RestoreProposalVersion(ctx context.Context, restored, version *entity.ProposalVersionEntity) error {
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		if err := q.DeleteProposalClasses(ctx, version.ProposalID); err != nil {
+			return err
+		}
+
+		if err := q.RestoreClassesFromVersion(ctx, sql.NullInt64{Int64: version.ID, Valid: true}); err != nil {
+			return err
+		}
+
+		if err := updateProposalFitness(ctx, q, version.ProposalID, version.Fitness, version.FitnessBreakdown); err != nil {
+			return err
+		}
+
+		return createProposalVersion(ctx, q, restored)
+	})
+}
+
+func (r *repository) FindApprovedProposalSummariesBySemesterId(ctx context.Context, semesterId int64) ([]entity.ProposalSummaryEntity, error) {
+	summaries, err := r.queries.FindApprovedProposalSummariesBySemesterId(ctx, semesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	var summariesEntity []entity.ProposalSummaryEntity
+	for _, summary := range summaries {
+		summariesEntity = append(summariesEntity, toProposalSummaryEntity(sqlc.FindLatestProposalSummariesBySemesterIdRow(summary)))
+	}
+	return summariesEntity, nil
+}
+
+func (r *repository) FindClassesByProfessorAndProposalIds(ctx context.Context, professorId int64, proposalIds []int64) ([]entity.ClassEntity, error) {
+	classes, err := r.queries.FindClassesByProfessorAndProposalIds(ctx, sqlc.FindClassesByProfessorAndProposalIdsParams{
+		ProfessorID: professorId,
+		ProposalIds: proposalIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var classesEntity []entity.ClassEntity
+	for _, class := range classes {
+		classesEntity = append(classesEntity, toClassEntity(sqlc.FindClassesByProposalIDRow(class)))
+	}
+	return classesEntity, nil
+}
+
+// saveProposalEdit runs the change to the classes of the proposal, then stores its fitness
+// and snapshots the classes as a new version, all or nothing.
+func (r *repository) saveProposalEdit(ctx context.Context, proposal *entity.ProposalEntity, version *entity.ProposalVersionEntity, change func(q *sqlc.Queries) error) error {
+	return transaction.Run(ctx, r.db, func(q *sqlc.Queries) error {
+		if err := change(q); err != nil {
+			return err
+		}
+
+		if err := updateProposalFitness(ctx, q, proposal.ID, proposal.Fitness, proposal.FitnessBreakdown); err != nil {
+			return err
+		}
+
+		return createProposalVersion(ctx, q, version)
+	})
+}
+
+func updateProposalFitness(ctx context.Context, q *sqlc.Queries, proposalId int64, fitness float64, fitnessBreakdown []entity.ConstraintScore) error {
+	breakdown, err := json.Marshal(fitnessBreakdown)
+	if err != nil {
+		return err
+	}
+
+	return q.UpdateProposalFitness(ctx, sqlc.UpdateProposalFitnessParams{
+		ID:               proposalId,
+		Fitness:          sql.NullFloat64{Float64: fitness, Valid: true},
+		FitnessBreakdown: breakdown,
+	})
+}
+
+// createProposalVersion numbers a new version after the last one of the proposal and copies
+// the current classes into it, so the snapshot is never touched by later edits.
+func createProposalVersion(ctx context.Context, q *sqlc.Queries, version *entity.ProposalVersionEntity) error {
+	created, err := q.CreateProposalVersion(ctx, sqlc.CreateProposalVersionParams{
+		Uuid:       version.UUID,
+		UserUuid:   uuid.NullUUID{UUID: version.UserUUID, Valid: version.UserUUID != uuid.Nil},
+		Reason:     sql.NullString{String: version.Reason, Valid: version.Reason != ""},
+		ProposalID: version.ProposalID,
+	})
+	if err != nil {
+		return err
+	}
+
+	version.ID = created.ID
+	version.Version = created.Version
+
+	return q.CopyClassesToVersion(ctx, sqlc.CopyClassesToVersionParams{
+		VersionID:  sql.NullInt64{Int64: created.ID, Valid: true},
+		ProposalID: version.ProposalID,
+	})
+}
+
+func toProposalVersionEntity(version sqlc.FindProposalVersionRow) (entity.ProposalVersionEntity, error) {
+	versionEntity := entity.ProposalVersionEntity{
+		ID:         version.ID,
+		UUID:       version.Uuid,
+		ProposalID: version.ProposalID,
+		Version:    version.Version,
+		UserUUID:   version.UserUuid.UUID,
+		UserName:   version.UserName.String,
+		Reason:     version.Reason.String,
+		Fitness:    version.Fitness.Float64,
+		CreatedAt:  version.CreatedAt,
+	}
+	if len(version.FitnessBreakdown) > 0 {
+		if err := json.Unmarshal(version.FitnessBreakdown, &versionEntity.FitnessBreakdown); err != nil {
+			return entity.ProposalVersionEntity{}, err
+		}
+	}
+	return versionEntity, nil
+}
+
+func toProposalSummaryEntity(summary sqlc.FindLatestProposalSummariesBySemesterIdRow) entity.ProposalSummaryEntity {
+	return entity.ProposalSummaryEntity{
+		ID:                summary.ID,
+		UUID:              summary.Uuid,
+		SemesterID:        summary.SemesterID,
+		CourseID:          summary.CourseID,
+		CourseName:        summary.CourseName,
+		SemesterName:      summary.SemesterName,
+		MaxCreditsToOffer: summary.MaxCreditsToOffer.Int32,
+	}
+}
+
+func toProposalEntity(proposal sqlc.Proposal) (entity.ProposalEntity, error) {
+	proposalEntity := entity.ProposalEntity{
+		ID:                 proposal.ID,
+		UUID:               proposal.Uuid,
+		SemesterID:         proposal.SemesterID,
+		CourseID:           proposal.CourseID,
+		Seed:               proposal.Seed.Int64,
+		Fitness:            proposal.Fitness.Float64,
+		ParameterizationID: proposal.ParameterizationID.Int64,
+		PopulationSize:     proposal.PopulationSize.Int32,
+		Generations:        proposal.Generations.Int32,
+		TournamentSize:     proposal.TournamentSize.Int32,
+		MutationRate:       proposal.MutationRate.Float64,
+		CreatedAt:          proposal.CreatedAt,
+		Status:             proposal.Status,
+	}
+	if len(proposal.FitnessBreakdown) > 0 {
+		if err := json.Unmarshal(proposal.FitnessBreakdown, &proposalEntity.FitnessBreakdown); err != nil {
+			return entity.ProposalEntity{}, err
+		}
+	}
+	return proposalEntity, nil
+}
@@ -0,0 +1,30 @@
+package roomrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewRoomRepository(db *sql.DB, q *sqlc.Queries) RoomRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type RoomRepository interface {
+	CreateRoom(ctx context.Context, u *entity.RoomEntity) error
+	FindRoomByID(ctx context.Context, uuid uuid.UUID) (*entity.RoomEntity, error)
+	UpdateRoom(ctx context.Context, u *entity.RoomEntity) error
+	DeleteRoom(ctx context.Context, uuid uuid.UUID) error
+	FindManyRooms(ctx context.Context) ([]entity.RoomEntity, error)
+	FindManyRoomsByCourseId(ctx context.Context, courseId int64) ([]entity.RoomEntity, error)
+}
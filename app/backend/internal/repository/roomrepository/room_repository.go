@@ -0,0 +1,95 @@
+package roomrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func (r *repository) CreateRoom(ctx context.Context, u *entity.RoomEntity) error {
+	err := r.queries.CreateRoom(ctx, sqlc.CreateRoomParams{
+		Uuid:     u.UUID,
+		Name:     u.Name,
+		Capacity: u.Capacity,
+		Type:     u.Type,
+		Location: u.Location,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindRoomByID(ctx context.Context, uuid uuid.UUID) (*entity.RoomEntity, error) {
+	room, err := r.queries.FindRoomByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	roomEntity := toRoomEntity(room)
+	return &roomEntity, nil
+}
+
+func (r *repository) UpdateRoom(ctx context.Context, u *entity.RoomEntity) error {
+	err := r.queries.UpdateRoom(ctx, sqlc.UpdateRoomParams{
+		Uuid:     u.UUID,
+		Name:     sql.NullString{String: u.Name, Valid: u.Name != ""},
+		Capacity: sql.NullInt32{Int32: u.Capacity, Valid: u.Capacity != 0},
+		Type:     sql.NullString{String: u.Type, Valid: u.Type != ""},
+		Location: sql.NullString{String: u.Location, Valid: u.Location != ""},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteRoom(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteRoom(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManyRooms(ctx context.Context) ([]entity.RoomEntity, error) {
+	rooms, err := r.queries.FindManyRooms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var roomsEntity []entity.RoomEntity
+	for _, room := range rooms {
+		roomsEntity = append(roomsEntity, toRoomEntity(room))
+	}
+	return roomsEntity, nil
+}
+
+func (r *repository) FindManyRoomsByCourseId(ctx context.Context, courseId int64) ([]entity.RoomEntity, error) {
+	rooms, err := r.queries.FindManyRoomsByCourseId(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	var roomsEntity []entity.RoomEntity
+	for _, room := range rooms {
+		roomsEntity = append(roomsEntity, toRoomEntity(room))
+	}
+	return roomsEntity, nil
+}
+
+func toRoomEntity(room sqlc.Room) entity.RoomEntity {
+	return entity.RoomEntity{
+		ID:       room.ID,
+		UUID:     room.Uuid,
+		Name:     room.Name,
+		Capacity: room.Capacity,
+		Type:     room.Type,
+		Location: room.Location,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
 	"github.com/robinsonvs/time-table-project/internal/entity"
+	"time"
 )
 
 func NewSemesterRepository(db *sql.DB, q *sqlc.Queries) SemesterRepository {
@@ -25,5 +26,12 @@ type SemesterRepository interface {
 	FindSemesterByID(ctx context.Context, uuid uuid.UUID) (*entity.SemesterEntity, error)
 	UpdateSemester(ctx context.Context, u *entity.SemesterEntity) error
 	DeleteSemester(ctx context.Context, uuid uuid.UUID) error
+	FindSemesterBySemesterId(ctx context.Context, id int64) (*entity.SemesterEntity, error)
 	FindManySemesters(ctx context.Context) ([]entity.SemesterEntity, error)
+	CreateSemesterHoliday(ctx context.Context, u *entity.SemesterHolidayEntity) error
+	FindSemesterHolidayByID(ctx context.Context, uuid uuid.UUID) (*entity.SemesterHolidayEntity, error)
+	FindSemesterHolidayByDate(ctx context.Context, semesterId int64, date time.Time) (*entity.SemesterHolidayEntity, error)
+	UpdateSemesterHoliday(ctx context.Context, u *entity.SemesterHolidayEntity) error
+	DeleteSemesterHoliday(ctx context.Context, uuid uuid.UUID) error
+	FindManySemesterHolidaysBySemesterId(ctx context.Context, semesterId int64) ([]entity.SemesterHolidayEntity, error)
 }
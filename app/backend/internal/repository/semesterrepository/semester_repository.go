@@ -6,12 +6,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
 	"github.com/robinsonvs/time-table-project/internal/entity"
+	"time"
 )
 
 func (r *repository) CreateSemester(ctx context.Context, u *entity.SemesterEntity) error {
 	err := r.queries.CreateSemester(ctx, sqlc.CreateSemesterParams{
-		Uuid:     u.UUID,
-		Semester: u.Semester,
+		Uuid:      u.UUID,
+		Semester:  u.Semester,
+		StartDate: sql.NullTime{Time: u.StartDate, Valid: !u.StartDate.IsZero()},
+		EndDate:   sql.NullTime{Time: u.EndDate, Valid: !u.EndDate.IsZero()},
 	})
 	if err != nil {
 		return err
@@ -26,18 +29,26 @@ func (r *repository) FindSemesterByID(ctx context.Context, uuid uuid.UUID) (*ent
 		return nil, err
 	}
 
-	semesterEntity := entity.SemesterEntity{
-		UUID:     semester.Uuid,
-		Semester: semester.Semester,
+	semesterEntity := toSemesterEntity(semester)
+	return &semesterEntity, nil
+}
+
+func (r *repository) FindSemesterBySemesterId(ctx context.Context, id int64) (*entity.SemesterEntity, error) {
+	semester, err := r.queries.FindSemesterBySemesterId(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
+	semesterEntity := toSemesterEntity(semester)
 	return &semesterEntity, nil
 }
 
 func (r *repository) UpdateSemester(ctx context.Context, u *entity.SemesterEntity) error {
 	err := r.queries.UpdateSemester(ctx, sqlc.UpdateSemesterParams{
-		Uuid:     u.UUID,
-		Semester: sql.NullString{String: u.Semester, Valid: u.Semester != ""},
+		Uuid:      u.UUID,
+		Semester:  sql.NullString{String: u.Semester, Valid: u.Semester != ""},
+		StartDate: sql.NullTime{Time: u.StartDate, Valid: !u.StartDate.IsZero()},
+		EndDate:   sql.NullTime{Time: u.EndDate, Valid: !u.EndDate.IsZero()},
 	})
 
 	if err != nil {
@@ -65,13 +76,99 @@ func (r *repository) FindManySemesters(ctx context.Context) ([]entity.SemesterEn
 
 	var semestersEntity []entity.SemesterEntity
 	for _, semester := range semesters {
-		semesterEntity := entity.SemesterEntity{
-			ID:       semester.ID,
-			UUID:     semester.Uuid,
-			Semester: semester.Semester,
-		}
-
-		semestersEntity = append(semestersEntity, semesterEntity)
+		semestersEntity = append(semestersEntity, toSemesterEntity(semester))
 	}
 	return semestersEntity, nil
 }
+
+func (r *repository) CreateSemesterHoliday(ctx context.Context, u *entity.SemesterHolidayEntity) error {
+	err := r.queries.CreateSemesterHoliday(ctx, sqlc.CreateSemesterHolidayParams{
+		Uuid:        u.UUID,
+		SemesterID:  u.SemesterID,
+		Date:        u.Date,
+		Description: u.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindSemesterHolidayByID(ctx context.Context, uuid uuid.UUID) (*entity.SemesterHolidayEntity, error) {
+	holiday, err := r.queries.FindSemesterHolidayByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	holidayEntity := toSemesterHolidayEntity(holiday)
+	return &holidayEntity, nil
+}
+
+func (r *repository) FindSemesterHolidayByDate(ctx context.Context, semesterId int64, date time.Time) (*entity.SemesterHolidayEntity, error) {
+	holiday, err := r.queries.FindSemesterHolidayByDate(ctx, sqlc.FindSemesterHolidayByDateParams{
+		SemesterID: semesterId,
+		Date:       date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holidayEntity := toSemesterHolidayEntity(holiday)
+	return &holidayEntity, nil
+}
+
+func (r *repository) UpdateSemesterHoliday(ctx context.Context, u *entity.SemesterHolidayEntity) error {
+	err := r.queries.UpdateSemesterHoliday(ctx, sqlc.UpdateSemesterHolidayParams{
+		Uuid:        u.UUID,
+		Date:        sql.NullTime{Time: u.Date, Valid: !u.Date.IsZero()},
+		Description: sql.NullString{String: u.Description, Valid: u.Description != ""},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteSemesterHoliday(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteSemesterHoliday(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManySemesterHolidaysBySemesterId(ctx context.Context, semesterId int64) ([]entity.SemesterHolidayEntity, error) {
+	holidays, err := r.queries.FindManySemesterHolidaysBySemesterId(ctx, semesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	var holidaysEntity []entity.SemesterHolidayEntity
+	for _, holiday := range holidays {
+		holidaysEntity = append(holidaysEntity, toSemesterHolidayEntity(holiday))
+	}
+	return holidaysEntity, nil
+}
+
+func toSemesterEntity(semester sqlc.Semester) entity.SemesterEntity {
+	return entity.SemesterEntity{
+		ID:        semester.ID,
+		UUID:      semester.Uuid,
+		Semester:  semester.Semester,
+		StartDate: semester.StartDate.Time,
+		EndDate:   semester.EndDate.Time,
+	}
+}
+
+func toSemesterHolidayEntity(holiday sqlc.SemesterHoliday) entity.SemesterHolidayEntity {
+	return entity.SemesterHolidayEntity{
+		ID:          holiday.ID,
+		UUID:        holiday.Uuid,
+		SemesterID:  holiday.SemesterID,
+		Date:        holiday.Date,
+		Description: holiday.Description,
+	}
+}
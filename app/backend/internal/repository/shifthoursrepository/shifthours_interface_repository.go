@@ -0,0 +1,31 @@
+package shifthoursrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewShiftHoursRepository(db *sql.DB, q *sqlc.Queries) ShiftHoursRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type ShiftHoursRepository interface {
+	CreateShiftHours(ctx context.Context, u *entity.ShiftHoursEntity) error
+	FindShiftHoursByID(ctx context.Context, uuid uuid.UUID) (*entity.ShiftHoursEntity, error)
+	FindShiftHoursByScope(ctx context.Context, shift string, courseId int64, location string) (*entity.ShiftHoursEntity, error)
+	UpdateShiftHours(ctx context.Context, u *entity.ShiftHoursEntity) error
+	DeleteShiftHours(ctx context.Context, uuid uuid.UUID) error
+	FindManyShiftHours(ctx context.Context) ([]entity.ShiftHoursEntity, error)
+	FindShiftHoursForCourse(ctx context.Context, courseId int64) ([]entity.ShiftHoursEntity, error)
+}
@@ -0,0 +1,109 @@
+package shifthoursrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func (r *repository) CreateShiftHours(ctx context.Context, u *entity.ShiftHoursEntity) error {
+	err := r.queries.CreateShiftHours(ctx, sqlc.CreateShiftHoursParams{
+		Uuid:      u.UUID,
+		Shift:     u.Shift,
+		StartTime: u.StartTime,
+		EndTime:   u.EndTime,
+		CourseID:  sql.NullInt64{Int64: u.CourseID, Valid: u.CourseID != 0},
+		Location:  sql.NullString{String: u.Location, Valid: u.Location != ""},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindShiftHoursByID(ctx context.Context, uuid uuid.UUID) (*entity.ShiftHoursEntity, error) {
+	shiftHours, err := r.queries.FindShiftHoursByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	shiftHoursEntity := toShiftHoursEntity(shiftHours)
+	return &shiftHoursEntity, nil
+}
+
+func (r *repository) FindShiftHoursByScope(ctx context.Context, shift string, courseId int64, location string) (*entity.ShiftHoursEntity, error) {
+	shiftHours, err := r.queries.FindShiftHoursByScope(ctx, sqlc.FindShiftHoursByScopeParams{
+		Shift:    shift,
+		CourseID: sql.NullInt64{Int64: courseId, Valid: courseId != 0},
+		Location: sql.NullString{String: location, Valid: location != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shiftHoursEntity := toShiftHoursEntity(shiftHours)
+	return &shiftHoursEntity, nil
+}
+
+func (r *repository) UpdateShiftHours(ctx context.Context, u *entity.ShiftHoursEntity) error {
+	err := r.queries.UpdateShiftHours(ctx, sqlc.UpdateShiftHoursParams{
+		Uuid:      u.UUID,
+		StartTime: u.StartTime,
+		EndTime:   u.EndTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteShiftHours(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteShiftHours(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManyShiftHours(ctx context.Context) ([]entity.ShiftHoursEntity, error) {
+	shiftHours, err := r.queries.FindManyShiftHours(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var shiftHoursEntity []entity.ShiftHoursEntity
+	for _, hours := range shiftHours {
+		shiftHoursEntity = append(shiftHoursEntity, toShiftHoursEntity(hours))
+	}
+	return shiftHoursEntity, nil
+}
+
+func (r *repository) FindShiftHoursForCourse(ctx context.Context, courseId int64) ([]entity.ShiftHoursEntity, error) {
+	shiftHours, err := r.queries.FindShiftHoursForCourse(ctx, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	var shiftHoursEntity []entity.ShiftHoursEntity
+	for _, hours := range shiftHours {
+		shiftHoursEntity = append(shiftHoursEntity, toShiftHoursEntity(hours))
+	}
+	return shiftHoursEntity, nil
+}
+
+func toShiftHoursEntity(shiftHours sqlc.ShiftHour) entity.ShiftHoursEntity {
+	return entity.ShiftHoursEntity{
+		ID:        shiftHours.ID,
+		UUID:      shiftHours.Uuid,
+		Shift:     shiftHours.Shift,
+		StartTime: shiftHours.StartTime,
+		EndTime:   shiftHours.EndTime,
+		CourseID:  shiftHours.CourseID.Int64,
+		Location:  shiftHours.Location.String,
+	}
+}
@@ -0,0 +1,30 @@
+package timeslotrepository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func NewTimeSlotRepository(db *sql.DB, q *sqlc.Queries) TimeSlotRepository {
+	return &repository{
+		db,
+		q,
+	}
+}
+
+type repository struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+type TimeSlotRepository interface {
+	CreateTimeSlot(ctx context.Context, u *entity.TimeSlotEntity) error
+	FindTimeSlotByID(ctx context.Context, uuid uuid.UUID) (*entity.TimeSlotEntity, error)
+	FindTimeSlotByLabel(ctx context.Context, label string) (*entity.TimeSlotEntity, error)
+	UpdateTimeSlot(ctx context.Context, u *entity.TimeSlotEntity) error
+	DeleteTimeSlot(ctx context.Context, uuid uuid.UUID) error
+	FindManyTimeSlots(ctx context.Context) ([]entity.TimeSlotEntity, error)
+}
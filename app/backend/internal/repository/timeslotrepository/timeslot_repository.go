@@ -0,0 +1,102 @@
+package timeslotrepository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/database/sqlc"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+)
+
+func (r *repository) CreateTimeSlot(ctx context.Context, u *entity.TimeSlotEntity) error {
+	err := r.queries.CreateTimeSlot(ctx, sqlc.CreateTimeSlotParams{
+		Uuid:      u.UUID,
+		Label:     u.Label,
+		Shift:     u.Shift,
+		StartTime: u.StartTime,
+		EndTime:   u.EndTime,
+		Weekdays:  weekdaysParam(u.Weekdays),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindTimeSlotByID(ctx context.Context, uuid uuid.UUID) (*entity.TimeSlotEntity, error) {
+	timeSlot, err := r.queries.FindTimeSlotByID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	timeSlotEntity := toTimeSlotEntity(timeSlot)
+	return &timeSlotEntity, nil
+}
+
+func (r *repository) FindTimeSlotByLabel(ctx context.Context, label string) (*entity.TimeSlotEntity, error) {
+	timeSlot, err := r.queries.FindTimeSlotByLabel(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	timeSlotEntity := toTimeSlotEntity(timeSlot)
+	return &timeSlotEntity, nil
+}
+
+func (r *repository) UpdateTimeSlot(ctx context.Context, u *entity.TimeSlotEntity) error {
+	err := r.queries.UpdateTimeSlot(ctx, sqlc.UpdateTimeSlotParams{
+		Uuid:      u.UUID,
+		Label:     u.Label,
+		Shift:     u.Shift,
+		StartTime: u.StartTime,
+		EndTime:   u.EndTime,
+		Weekdays:  weekdaysParam(u.Weekdays),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteTimeSlot(ctx context.Context, uuid uuid.UUID) error {
+	err := r.queries.DeleteTimeSlot(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) FindManyTimeSlots(ctx context.Context) ([]entity.TimeSlotEntity, error) {
+	timeSlots, err := r.queries.FindManyTimeSlots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeSlotsEntity []entity.TimeSlotEntity
+	for _, timeSlot := range timeSlots {
+		timeSlotsEntity = append(timeSlotsEntity, toTimeSlotEntity(timeSlot))
+	}
+	return timeSlotsEntity, nil
+}
+
+// weekdaysParam keeps an empty list of weekdays from being stored as NULL.
+func weekdaysParam(weekdays []string) []string {
+	if weekdays == nil {
+		return []string{}
+	}
+	return weekdays
+}
+
+func toTimeSlotEntity(timeSlot sqlc.TimeSlot) entity.TimeSlotEntity {
+	return entity.TimeSlotEntity{
+		ID:        timeSlot.ID,
+		UUID:      timeSlot.Uuid,
+		Label:     timeSlot.Label,
+		Shift:     timeSlot.Shift,
+		StartTime: timeSlot.StartTime,
+		EndTime:   timeSlot.EndTime,
+		Weekdays:  timeSlot.Weekdays,
+	}
+}
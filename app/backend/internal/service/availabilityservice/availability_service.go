@@ -9,18 +9,35 @@ import (
 	"github.com/robinsonvs/time-table-project/internal/entity"
 	"github.com/robinsonvs/time-table-project/internal/handler/response"
 	"log/slog"
+	"time"
 )
 
+// timeOfDayLayout is how the start and end of availabilities are written.
+const timeOfDayLayout = "15:04"
+
 func (s *service) CreateAvailability(ctx context.Context, u dto.CreateAvailabilityDto) error {
+	startTime, err := time.Parse(timeOfDayLayout, u.StartTime)
+	if err != nil {
+		return err
+	}
+	endTime, err := time.Parse(timeOfDayLayout, u.EndTime)
+	if err != nil {
+		return err
+	}
+	if !endTime.After(startTime) {
+		slog.Error("end time must be after start time", slog.String("package", "availabilityservice"))
+		return errors.New("end time must be after start time")
+	}
 
 	newAvailability := entity.AvailabilityEntity{
 		UUID:        uuid.New(),
 		DayOfWeek:   u.DayOfWeek,
-		Shift:       u.Shift,
+		StartTime:   startTime,
+		EndTime:     endTime,
 		ProfessorID: u.ProfessorId,
 	}
 
-	err := s.repo.CreateAvailability(ctx, &newAvailability)
+	err = s.repo.CreateAvailability(ctx, &newAvailability)
 	if err != nil {
 		slog.Error("error to create availability", "err", err, slog.String("package", "availabilityservice"))
 		return err
@@ -48,12 +65,29 @@ func (s *service) UpdateAvailability(ctx context.Context, u dto.UpdateAvailabili
 	updateAvailability := entity.AvailabilityEntity{
 		UUID:      uuid,
 		DayOfWeek: u.DayOfWeek,
-		Shift:     u.Shift,
+		StartTime: availabilityExists.StartTime,
+		EndTime:   availabilityExists.EndTime,
+	}
+	if u.StartTime != "" {
+		updateAvailability.StartTime, err = time.Parse(timeOfDayLayout, u.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+	if u.EndTime != "" {
+		updateAvailability.EndTime, err = time.Parse(timeOfDayLayout, u.EndTime)
+		if err != nil {
+			return err
+		}
+	}
+	if !updateAvailability.EndTime.After(updateAvailability.StartTime) {
+		slog.Error("end time must be after start time", slog.String("package", "availabilityservice"))
+		return errors.New("end time must be after start time")
 	}
 
 	err = s.repo.UpdateAvailability(ctx, &updateAvailability)
 	if err != nil {
-		slog.Error("error to update discipline", "err", err, slog.String("package", "disciplineservice"))
+		slog.Error("error to update availability", "err", err, slog.String("package", "availabilityservice"))
 		return err
 	}
 
@@ -75,7 +109,8 @@ func (s *service) GetAvailabilityByID(ctx context.Context, uuid uuid.UUID) (*res
 	availability := response.AvailabilityResponse{
 		UUID:        availabilityExists.UUID.String(),
 		DayOfWeek:   availabilityExists.DayOfWeek,
-		Shift:       availabilityExists.Shift,
+		StartTime:   availabilityExists.StartTime.Format(timeOfDayLayout),
+		EndTime:     availabilityExists.EndTime.Format(timeOfDayLayout),
 		ProfessorId: availabilityExists.ProfessorID,
 	}
 
@@ -95,7 +130,8 @@ func (s *service) FindManyAvailabilities(ctx context.Context) (*response.ManyAva
 			Id:          availabilityEntity.ID,
 			UUID:        availabilityEntity.UUID.String(),
 			DayOfWeek:   availabilityEntity.DayOfWeek,
-			Shift:       availabilityEntity.Shift,
+			StartTime:   availabilityEntity.StartTime.Format(timeOfDayLayout),
+			EndTime:     availabilityEntity.EndTime.Format(timeOfDayLayout),
 			ProfessorId: availabilityEntity.ProfessorID,
 		}
 		availabilities.Availabilities = append(availabilities.Availabilities, availabilityResponse)
@@ -117,7 +153,8 @@ func (s *service) FindManyAvailabilitiesByProfessorId(ctx context.Context, cours
 			Id:          availabilityEntity.ID,
 			UUID:        availabilityEntity.UUID.String(),
 			DayOfWeek:   availabilityEntity.DayOfWeek,
-			Shift:       availabilityEntity.Shift,
+			StartTime:   availabilityEntity.StartTime.Format(timeOfDayLayout),
+			EndTime:     availabilityEntity.EndTime.Format(timeOfDayLayout),
 			ProfessorId: availabilityEntity.ProfessorID,
 		}
 		availabilitiesByProfessor.Availabilities = append(availabilitiesByProfessor.Availabilities, availabilityResponse)
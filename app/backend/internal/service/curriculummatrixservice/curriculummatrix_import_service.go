@@ -0,0 +1,166 @@
+package curriculummatrixservice
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// ImportInvalidFile starts the message of every error caused by the content of an
+// imported file, so callers can tell them from failures to store the matrix.
+const ImportInvalidFile = "invalid curriculum matrix file"
+
+// ImportCurriculumMatrix replaces the disciplines of the matrix with the ones listed in a
+// CSV file. The first line names the columns: code and term are required, mandatory is
+// optional and defaults to true. Columns may be separated by commas or semicolons, as
+// spreadsheets export them. Nothing is changed unless every line is valid.
+func (s *service) ImportCurriculumMatrix(ctx context.Context, id uuid.UUID, file io.Reader) (*response.CurriculumMatrixResponse, error) {
+	curriculumMatrixExists, err := s.repo.FindCurriculumMatrixByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("curriculum matrix not found", slog.String("package", "curriculummatrixservice"))
+			return nil, errors.New("curriculum matrix not found")
+		}
+		slog.Error("error to search curriculum matrix by id", "err", err, slog.String("package", "curriculummatrixservice"))
+		return nil, err
+	}
+
+	lines, err := readImportFile(file)
+	if err != nil {
+		slog.Error("error to read curriculum matrix file", "err", err, slog.String("package", "curriculummatrixservice"))
+		return nil, err
+	}
+
+	seen := make(map[int64]int)
+	var disciplines []entity.CurriculumMatrixDisciplineEntity
+	for _, line := range lines {
+		discipline, err := s.repo.FindDisciplineOfCurriculumMatrixCourseByCode(ctx, curriculumMatrixExists.ID, line.code)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("%s: line %d: discipline %s not found in the course", ImportInvalidFile, line.number, line.code)
+			}
+			slog.Error("error to search discipline by code", "err", err, slog.String("package", "curriculummatrixservice"))
+			return nil, err
+		}
+		if previous, ok := seen[discipline.ID]; ok {
+			return nil, fmt.Errorf("%s: line %d: discipline %s already listed on line %d", ImportInvalidFile, line.number, line.code, previous)
+		}
+		seen[discipline.ID] = line.number
+
+		disciplines = append(disciplines, entity.CurriculumMatrixDisciplineEntity{
+			UUID:               uuid.New(),
+			CurriculumMatrixID: curriculumMatrixExists.ID,
+			DisciplineID:       discipline.ID,
+			Term:               line.term,
+			Mandatory:          line.mandatory,
+		})
+	}
+
+	err = s.repo.ReplaceCurriculumMatrixDisciplines(ctx, curriculumMatrixExists.ID, disciplines)
+	if err != nil {
+		slog.Error("error to import curriculum matrix disciplines", "err", err, slog.String("package", "curriculummatrixservice"))
+		return nil, err
+	}
+
+	curriculumMatrixExists.Disciplines, err = s.repo.FindManyCurriculumMatrixDisciplinesByCurriculumMatrixId(ctx, curriculumMatrixExists.ID)
+	if err != nil {
+		slog.Error("error to find curriculum matrix disciplines", "err", err, slog.String("package", "curriculummatrixservice"))
+		return nil, err
+	}
+
+	curriculumMatrix := toCurriculumMatrixResponse(*curriculumMatrixExists)
+	return &curriculumMatrix, nil
+}
+
+type importLine struct {
+	number    int
+	code      string
+	term      int32
+	mandatory bool
+}
+
+func readImportFile(file io.Reader) ([]importLine, error) {
+	buffered := bufio.NewReader(file)
+	header, err := buffered.Peek(buffered.Size())
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	firstLine, _, _ := strings.Cut(string(header), "\n")
+
+	reader := csv.NewReader(buffered)
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	names, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%s: the file is empty", ImportInvalidFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", ImportInvalidFile, err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range names {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"code", "term"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%s: missing column %s", ImportInvalidFile, required)
+		}
+	}
+
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var lines []importLine
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ImportInvalidFile, err)
+		}
+		// blank lines are skipped by the reader, so the line is taken from it rather than counted
+		number, _ := reader.FieldPos(0)
+
+		code := field(record, "code")
+		if code == "" {
+			continue
+		}
+
+		term, err := strconv.ParseInt(field(record, "term"), 10, 32)
+		if err != nil || term < 1 || term > 20 {
+			return nil, fmt.Errorf("%s: line %d: term must be a number from 1 to 20", ImportInvalidFile, number)
+		}
+
+		mandatory := true
+		if value := field(record, "mandatory"); value != "" {
+			mandatory, err = strconv.ParseBool(strings.ToLower(value))
+			if err != nil {
+				return nil, fmt.Errorf("%s: line %d: mandatory must be true or false", ImportInvalidFile, number)
+			}
+		}
+
+		lines = append(lines, importLine{number: number, code: code, term: int32(term), mandatory: mandatory})
+	}
+	return lines, nil
+}
@@ -0,0 +1,32 @@
+package curriculummatrixservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/curriculummatrixrepository"
+	"io"
+)
+
+func NewCurriculumMatrixService(repo curriculummatrixrepository.CurriculumMatrixRepository) CurriculumMatrixService {
+	return &service{
+		repo,
+	}
+}
+
+type service struct {
+	repo curriculummatrixrepository.CurriculumMatrixRepository
+}
+
+type CurriculumMatrixService interface {
+	CreateCurriculumMatrix(ctx context.Context, u dto.CreateCurriculumMatrixDto) error
+	UpdateCurriculumMatrix(ctx context.Context, u dto.UpdateCurriculumMatrixDto, uuid uuid.UUID) error
+	GetCurriculumMatrixByID(ctx context.Context, uuid uuid.UUID) (*response.CurriculumMatrixResponse, error)
+	DeleteCurriculumMatrix(ctx context.Context, uuid uuid.UUID) error
+	FindManyCurriculumMatricesByCourseId(ctx context.Context, courseId int64) (*response.ManyCurriculumMatricesResponse, error)
+	ImportCurriculumMatrix(ctx context.Context, uuid uuid.UUID, file io.Reader) (*response.CurriculumMatrixResponse, error)
+	CreateCurriculumMatrixDiscipline(ctx context.Context, u dto.CreateCurriculumMatrixDisciplineDto) error
+	UpdateCurriculumMatrixDiscipline(ctx context.Context, u dto.UpdateCurriculumMatrixDisciplineDto, uuid uuid.UUID) error
+	DeleteCurriculumMatrixDiscipline(ctx context.Context, uuid uuid.UUID) error
+}
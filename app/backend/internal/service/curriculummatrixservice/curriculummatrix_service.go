@@ -0,0 +1,256 @@
+package curriculummatrixservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+)
+
+func (s *service) CreateCurriculumMatrix(ctx context.Context, u dto.CreateCurriculumMatrixDto) error {
+	curriculumMatrixExists, err := s.repo.FindCurriculumMatrixByVersion(ctx, u.CourseId, u.Version)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search curriculum matrix by version", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	if curriculumMatrixExists != nil {
+		slog.Error("curriculum matrix version already exists", slog.String("package", "curriculummatrixservice"))
+		return errors.New("curriculum matrix version already exists")
+	}
+
+	newCurriculumMatrix := entity.CurriculumMatrixEntity{
+		UUID:     uuid.New(),
+		CourseID: u.CourseId,
+		Version:  u.Version,
+		Active:   u.Active,
+	}
+
+	err = s.repo.CreateCurriculumMatrix(ctx, &newCurriculumMatrix)
+	if err != nil {
+		slog.Error("error to create curriculum matrix", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateCurriculumMatrix(ctx context.Context, u dto.UpdateCurriculumMatrixDto, uuid uuid.UUID) error {
+	curriculumMatrixExists, err := s.repo.FindCurriculumMatrixByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("curriculum matrix not found", slog.String("package", "curriculummatrixservice"))
+			return errors.New("curriculum matrix not found")
+		}
+		slog.Error("error to search curriculum matrix by id", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	if u.Version != "" && u.Version != curriculumMatrixExists.Version {
+		versionExists, err := s.repo.FindCurriculumMatrixByVersion(ctx, curriculumMatrixExists.CourseID, u.Version)
+		if err != nil && err != sql.ErrNoRows {
+			slog.Error("error to search curriculum matrix by version", "err", err, slog.String("package", "curriculummatrixservice"))
+			return err
+		}
+
+		if versionExists != nil {
+			slog.Error("curriculum matrix version already exists", slog.String("package", "curriculummatrixservice"))
+			return errors.New("curriculum matrix version already exists")
+		}
+		curriculumMatrixExists.Version = u.Version
+	}
+
+	// only the fields sent in the body are changed
+	if u.Active != nil {
+		curriculumMatrixExists.Active = *u.Active
+	}
+
+	err = s.repo.UpdateCurriculumMatrix(ctx, curriculumMatrixExists)
+	if err != nil {
+		slog.Error("error to update curriculum matrix", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetCurriculumMatrixByID(ctx context.Context, uuid uuid.UUID) (*response.CurriculumMatrixResponse, error) {
+	curriculumMatrixExists, err := s.repo.FindCurriculumMatrixByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("curriculum matrix not found", slog.String("package", "curriculummatrixservice"))
+			return nil, errors.New("curriculum matrix not found")
+		}
+		slog.Error("error to search curriculum matrix by id", "err", err, slog.String("package", "curriculummatrixservice"))
+		return nil, err
+	}
+
+	curriculumMatrixExists.Disciplines, err = s.repo.FindManyCurriculumMatrixDisciplinesByCurriculumMatrixId(ctx, curriculumMatrixExists.ID)
+	if err != nil {
+		slog.Error("error to find curriculum matrix disciplines", "err", err, slog.String("package", "curriculummatrixservice"))
+		return nil, err
+	}
+
+	curriculumMatrix := toCurriculumMatrixResponse(*curriculumMatrixExists)
+	return &curriculumMatrix, nil
+}
+
+func (s *service) DeleteCurriculumMatrix(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindCurriculumMatrixByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("curriculum matrix not found", slog.String("package", "curriculummatrixservice"))
+			return errors.New("curriculum matrix not found")
+		}
+		slog.Error("error to search curriculum matrix by id", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	err = s.repo.DeleteCurriculumMatrix(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete curriculum matrix", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) FindManyCurriculumMatricesByCourseId(ctx context.Context, courseId int64) (*response.ManyCurriculumMatricesResponse, error) {
+	findManyCurriculumMatrices, err := s.repo.FindManyCurriculumMatricesByCourseId(ctx, courseId)
+	if err != nil {
+		slog.Error("error to find many curriculum matrices", "err", err, slog.String("package", "curriculummatrixservice"))
+		return nil, err
+	}
+
+	curriculumMatrices := response.ManyCurriculumMatricesResponse{}
+	for _, curriculumMatrixEntity := range findManyCurriculumMatrices {
+		curriculumMatrices.CurriculumMatrices = append(curriculumMatrices.CurriculumMatrices, toCurriculumMatrixResponse(curriculumMatrixEntity))
+	}
+
+	return &curriculumMatrices, nil
+}
+
+func (s *service) CreateCurriculumMatrixDiscipline(ctx context.Context, u dto.CreateCurriculumMatrixDisciplineDto) error {
+	belongs, err := s.repo.IsDisciplineOfCurriculumMatrixCourse(ctx, u.CurriculumMatrixId, u.DisciplineId)
+	if err != nil {
+		slog.Error("error to check discipline course", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	if !belongs {
+		slog.Error("discipline does not belong to the curriculum matrix course", slog.String("package", "curriculummatrixservice"))
+		return errors.New("discipline does not belong to the curriculum matrix course")
+	}
+
+	curriculumMatrixDisciplineExists, err := s.repo.FindCurriculumMatrixDisciplineByDisciplineId(ctx, u.CurriculumMatrixId, u.DisciplineId)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search curriculum matrix discipline", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	if curriculumMatrixDisciplineExists != nil {
+		slog.Error("discipline already in this curriculum matrix", slog.String("package", "curriculummatrixservice"))
+		return errors.New("discipline already in this curriculum matrix")
+	}
+
+	// disciplines are mandatory unless the body says otherwise
+	mandatory := true
+	if u.Mandatory != nil {
+		mandatory = *u.Mandatory
+	}
+
+	newCurriculumMatrixDiscipline := entity.CurriculumMatrixDisciplineEntity{
+		UUID:               uuid.New(),
+		CurriculumMatrixID: u.CurriculumMatrixId,
+		DisciplineID:       u.DisciplineId,
+		Term:               u.Term,
+		Mandatory:          mandatory,
+	}
+
+	err = s.repo.CreateCurriculumMatrixDiscipline(ctx, &newCurriculumMatrixDiscipline)
+	if err != nil {
+		slog.Error("error to create curriculum matrix discipline", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateCurriculumMatrixDiscipline(ctx context.Context, u dto.UpdateCurriculumMatrixDisciplineDto, uuid uuid.UUID) error {
+	curriculumMatrixDisciplineExists, err := s.repo.FindCurriculumMatrixDisciplineByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("curriculum matrix discipline not found", slog.String("package", "curriculummatrixservice"))
+			return errors.New("curriculum matrix discipline not found")
+		}
+		slog.Error("error to search curriculum matrix discipline by id", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	// only the fields sent in the body are changed
+	if u.Term != nil {
+		curriculumMatrixDisciplineExists.Term = *u.Term
+	}
+	if u.Mandatory != nil {
+		curriculumMatrixDisciplineExists.Mandatory = *u.Mandatory
+	}
+
+	err = s.repo.UpdateCurriculumMatrixDiscipline(ctx, curriculumMatrixDisciplineExists)
+	if err != nil {
+		slog.Error("error to update curriculum matrix discipline", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) DeleteCurriculumMatrixDiscipline(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindCurriculumMatrixDisciplineByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("curriculum matrix discipline not found", slog.String("package", "curriculummatrixservice"))
+			return errors.New("curriculum matrix discipline not found")
+		}
+		slog.Error("error to search curriculum matrix discipline by id", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	err = s.repo.DeleteCurriculumMatrixDiscipline(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete curriculum matrix discipline", "err", err, slog.String("package", "curriculummatrixservice"))
+		return err
+	}
+
+	return nil
+}
+
+func toCurriculumMatrixResponse(cm entity.CurriculumMatrixEntity) response.CurriculumMatrixResponse {
+	res := response.CurriculumMatrixResponse{
+		Id:       cm.ID,
+		UUID:     cm.UUID.String(),
+		CourseId: cm.CourseID,
+		Version:  cm.Version,
+		Active:   cm.Active,
+	}
+	for _, cmd := range cm.Disciplines {
+		discipline := response.CurriculumMatrixDisciplineResponse{
+			Id:                 cmd.ID,
+			UUID:               cmd.UUID.String(),
+			CurriculumMatrixId: cmd.CurriculumMatrixID,
+			DisciplineId:       cmd.DisciplineID,
+			Term:               cmd.Term,
+			Mandatory:          cmd.Mandatory,
+		}
+		if cmd.Discipline != nil {
+			discipline.DisciplineCode = cmd.Discipline.Code
+			discipline.DisciplineName = cmd.Discipline.Name
+			discipline.Credits = cmd.Discipline.Credits
+		}
+		res.Disciplines = append(res.Disciplines, discipline)
+	}
+	return res
+}
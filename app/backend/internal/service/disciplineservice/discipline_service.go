@@ -12,15 +12,29 @@ import (
 )
 
 func (s *service) CreateDiscipline(ctx context.Context, u dto.CreateDisciplineDto) error {
+	codeExists, err := s.repo.FindDisciplineByCode(ctx, u.Code)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search discipline by code", "err", err, slog.String("package", "disciplineservice"))
+		return err
+	}
+
+	if codeExists != nil {
+		slog.Error("discipline code already exists", slog.String("package", "disciplineservice"))
+		return errors.New("discipline code already exists")
+	}
 
 	newDiscipline := entity.DisciplineEntity{
-		UUID:     uuid.New(),
-		Name:     u.Name,
-		Credits:  u.Credits,
-		CourseID: u.CourseId,
+		UUID:              uuid.New(),
+		Name:              u.Name,
+		Credits:           u.Credits,
+		CourseID:          u.CourseId,
+		RoomType:          u.RoomType,
+		ExpectedEnrolment: u.ExpectedEnrolment,
+		Term:              u.Term,
+		Code:              u.Code,
 	}
 
-	err := s.repo.CreateDiscipline(ctx, &newDiscipline)
+	err = s.repo.CreateDiscipline(ctx, &newDiscipline)
 	if err != nil {
 		slog.Error("error to create discipline", "err", err, slog.String("package", "disciplineservice"))
 		return err
@@ -45,10 +59,33 @@ func (s *service) UpdateDiscipline(ctx context.Context, u dto.UpdateDisciplineDt
 		return errors.New("discipline already exists")
 	}
 
+	if u.Code != "" && u.Code != disciplineExists.Code {
+		codeExists, err := s.repo.FindDisciplineByCode(ctx, u.Code)
+		if err != nil && err != sql.ErrNoRows {
+			slog.Error("error to search discipline by code", "err", err, slog.String("package", "disciplineservice"))
+			return err
+		}
+
+		if codeExists != nil {
+			slog.Error("discipline code already exists", slog.String("package", "disciplineservice"))
+			return errors.New("discipline code already exists")
+		}
+	}
+
+	// the term is kept unless the body sends one, 0 taking the discipline out of any term
+	term := disciplineExists.Term
+	if u.Term != nil {
+		term = *u.Term
+	}
+
 	updateDiscipline := entity.DisciplineEntity{
-		UUID:    uuid,
-		Name:    u.Name,
-		Credits: u.Credits,
+		UUID:              uuid,
+		Name:              u.Name,
+		Credits:           u.Credits,
+		RoomType:          u.RoomType,
+		ExpectedEnrolment: u.ExpectedEnrolment,
+		Term:              term,
+		Code:              u.Code,
 	}
 
 	err = s.repo.UpdateDiscipline(ctx, &updateDiscipline)
@@ -73,10 +110,14 @@ func (s *service) GetDisciplineByID(ctx context.Context, uuid uuid.UUID) (*respo
 	}
 
 	discipline := response.DisciplineResponse{
-		UUID:     disciplineExists.UUID.String(),
-		Name:     disciplineExists.Name,
-		Credits:  disciplineExists.Credits,
-		CourseId: disciplineExists.CourseID,
+		UUID:              disciplineExists.UUID.String(),
+		Name:              disciplineExists.Name,
+		Credits:           disciplineExists.Credits,
+		CourseId:          disciplineExists.CourseID,
+		RoomType:          disciplineExists.RoomType,
+		ExpectedEnrolment: disciplineExists.ExpectedEnrolment,
+		Term:              disciplineExists.Term,
+		Code:              disciplineExists.Code,
 	}
 
 	return &discipline, nil
@@ -92,11 +133,15 @@ func (s *service) FindManyDisciplines(ctx context.Context) (*response.ManyDiscip
 	disciplines := response.ManyDisciplinesResponse{}
 	for _, disciplineEntity := range findManyDisciplines {
 		disciplineResponse := response.DisciplineResponse{
-			Id:       disciplineEntity.ID,
-			UUID:     disciplineEntity.UUID.String(),
-			Name:     disciplineEntity.Name,
-			Credits:  disciplineEntity.Credits,
-			CourseId: disciplineEntity.CourseID,
+			Id:                disciplineEntity.ID,
+			UUID:              disciplineEntity.UUID.String(),
+			Name:              disciplineEntity.Name,
+			Credits:           disciplineEntity.Credits,
+			CourseId:          disciplineEntity.CourseID,
+			RoomType:          disciplineEntity.RoomType,
+			ExpectedEnrolment: disciplineEntity.ExpectedEnrolment,
+			Term:              disciplineEntity.Term,
+			Code:              disciplineEntity.Code,
 		}
 		disciplines.Disciplines = append(disciplines.Disciplines, disciplineResponse)
 	}
@@ -114,11 +159,15 @@ func (s *service) FindManyDisciplinesByCourseId(ctx context.Context, courseId in
 	disciplinesByCourse := response.ManyDisciplinesResponse{}
 	for _, disciplineEntity := range findManyDisciplinesByCourse {
 		disciplineResponse := response.DisciplineResponse{
-			Id:       disciplineEntity.ID,
-			UUID:     disciplineEntity.UUID.String(),
-			Name:     disciplineEntity.Name,
-			Credits:  disciplineEntity.Credits,
-			CourseId: disciplineEntity.CourseID,
+			Id:                disciplineEntity.ID,
+			UUID:              disciplineEntity.UUID.String(),
+			Name:              disciplineEntity.Name,
+			Credits:           disciplineEntity.Credits,
+			CourseId:          disciplineEntity.CourseID,
+			RoomType:          disciplineEntity.RoomType,
+			ExpectedEnrolment: disciplineEntity.ExpectedEnrolment,
+			Term:              disciplineEntity.Term,
+			Code:              disciplineEntity.Code,
 		}
 		disciplinesByCourse.Disciplines = append(disciplinesByCourse.Disciplines, disciplineResponse)
 	}
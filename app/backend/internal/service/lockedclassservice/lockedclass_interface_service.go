@@ -0,0 +1,31 @@
+package lockedclassservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/lockedclassrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/proposalrepository"
+)
+
+func NewLockedClassService(repo lockedclassrepository.LockedClassRepository, proposalRepo proposalrepository.ProposalRepository) LockedClassService {
+	return &service{
+		repo,
+		proposalRepo,
+	}
+}
+
+type service struct {
+	repo         lockedclassrepository.LockedClassRepository
+	proposalRepo proposalrepository.ProposalRepository
+}
+
+type LockedClassService interface {
+	CreateLockedClass(ctx context.Context, u dto.CreateLockedClassDto) error
+	LockProposalClasses(ctx context.Context, u dto.LockProposalClassesDto, proposalUUID uuid.UUID) error
+	UpdateLockedClass(ctx context.Context, u dto.UpdateLockedClassDto, uuid uuid.UUID) error
+	GetLockedClassByID(ctx context.Context, uuid uuid.UUID) (*response.LockedClassResponse, error)
+	DeleteLockedClass(ctx context.Context, uuid uuid.UUID) error
+	FindManyLockedClassesByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyLockedClassesResponse, error)
+}
@@ -0,0 +1,316 @@
+package lockedclassservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// timeOfDayLayout is how the start and end of locked classes are written.
+const timeOfDayLayout = "15:04"
+
+func (s *service) CreateLockedClass(ctx context.Context, u dto.CreateLockedClassDto) error {
+	startTime, err := time.Parse(timeOfDayLayout, u.StartTime)
+	if err != nil {
+		return err
+	}
+	endTime, err := time.Parse(timeOfDayLayout, u.EndTime)
+	if err != nil {
+		return err
+	}
+
+	// classes go to the first section unless the body says otherwise
+	section := u.Section
+	if section == 0 {
+		section = 1
+	}
+
+	newLockedClass := entity.LockedClassEntity{
+		UUID:               uuid.New(),
+		ParameterizationID: u.ParameterizationId,
+		DisciplineID:       u.DisciplineId,
+		ProfessorID:        u.ProfessorId,
+		RoomID:             u.RoomId,
+		Section:            section,
+		DayOfWeek:          u.DayOfWeek,
+		StartTime:          startTime,
+		EndTime:            endTime,
+	}
+
+	err = s.checkDisciplineCourse(ctx, newLockedClass)
+	if err != nil {
+		return err
+	}
+
+	lockedClasses, err := s.repo.FindManyLockedClassesByParameterizationId(ctx, u.ParameterizationId)
+	if err != nil {
+		slog.Error("error to find many locked classes", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+	err = validateLockedClass(newLockedClass, lockedClasses)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.CreateLockedClass(ctx, &newLockedClass)
+	if err != nil {
+		slog.Error("error to create locked class", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	return nil
+}
+
+// LockProposalClasses locks classes of an existing proposal on the parameterization: the
+// ones listed in the body or, when it lists none, every class of the proposal.
+func (s *service) LockProposalClasses(ctx context.Context, u dto.LockProposalClassesDto, proposalUUID uuid.UUID) error {
+	proposal, err := s.proposalRepo.FindProposalByID(ctx, proposalUUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "lockedclassservice"))
+			return errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	classes, err := s.proposalRepo.FindClassesByProposalID(ctx, proposal.ID)
+	if err != nil {
+		slog.Error("error to find classes of proposal", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	if len(u.ClassUuids) > 0 {
+		var chosen []entity.ClassEntity
+		for _, classUUID := range u.ClassUuids {
+			i := slices.IndexFunc(classes, func(class entity.ClassEntity) bool { return class.UUID.String() == classUUID })
+			if i < 0 {
+				slog.Error("class not found in proposal", slog.String("package", "lockedclassservice"))
+				return errors.New("class not found in proposal")
+			}
+			chosen = append(chosen, classes[i])
+		}
+		classes = chosen
+	}
+
+	lockedClasses, err := s.repo.FindManyLockedClassesByParameterizationId(ctx, u.ParameterizationId)
+	if err != nil {
+		slog.Error("error to find many locked classes", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	// every class is checked before any is locked, so a conflict locks none of them
+	var newLockedClasses []entity.LockedClassEntity
+	for _, class := range classes {
+		newLockedClass := entity.LockedClassEntity{
+			UUID:               uuid.New(),
+			ParameterizationID: u.ParameterizationId,
+			DisciplineID:       class.DisciplineID,
+			ProfessorID:        class.ProfessorID,
+			RoomID:             class.RoomID,
+			Section:            class.Section,
+			DayOfWeek:          class.DayOfWeek,
+			StartTime:          timeOfDay(class.StartTime),
+			EndTime:            timeOfDay(class.EndTime),
+		}
+
+		err = s.checkDisciplineCourse(ctx, newLockedClass)
+		if err != nil {
+			return err
+		}
+		err = validateLockedClass(newLockedClass, lockedClasses)
+		if err != nil {
+			return err
+		}
+		lockedClasses = append(lockedClasses, newLockedClass)
+		newLockedClasses = append(newLockedClasses, newLockedClass)
+	}
+
+	err = s.repo.CreateLockedClasses(ctx, newLockedClasses)
+	if err != nil {
+		slog.Error("error to create locked classes", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateLockedClass(ctx context.Context, u dto.UpdateLockedClassDto, uuid uuid.UUID) error {
+	lockedClassExists, err := s.repo.FindLockedClassByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("locked class not found", slog.String("package", "lockedclassservice"))
+			return errors.New("locked class not found")
+		}
+		slog.Error("error to search locked class by id", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	// only the fields sent in the body are changed
+	updateLockedClass := *lockedClassExists
+	if u.ProfessorId != nil {
+		updateLockedClass.ProfessorID = *u.ProfessorId
+	}
+	if u.RoomId != nil {
+		updateLockedClass.RoomID = *u.RoomId
+	}
+	if u.Section != nil {
+		updateLockedClass.Section = *u.Section
+	}
+	if u.DayOfWeek != "" {
+		updateLockedClass.DayOfWeek = u.DayOfWeek
+	}
+	if u.StartTime != "" {
+		updateLockedClass.StartTime, err = time.Parse(timeOfDayLayout, u.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+	if u.EndTime != "" {
+		updateLockedClass.EndTime, err = time.Parse(timeOfDayLayout, u.EndTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	lockedClasses, err := s.repo.FindManyLockedClassesByParameterizationId(ctx, updateLockedClass.ParameterizationID)
+	if err != nil {
+		slog.Error("error to find many locked classes", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+	err = validateLockedClass(updateLockedClass, lockedClasses)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateLockedClass(ctx, &updateLockedClass)
+	if err != nil {
+		slog.Error("error to update locked class", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetLockedClassByID(ctx context.Context, uuid uuid.UUID) (*response.LockedClassResponse, error) {
+	lockedClassExists, err := s.repo.FindLockedClassByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("locked class not found", slog.String("package", "lockedclassservice"))
+			return nil, errors.New("locked class not found")
+		}
+		slog.Error("error to search locked class by id", "err", err, slog.String("package", "lockedclassservice"))
+		return nil, err
+	}
+
+	lockedClass := toLockedClassResponse(*lockedClassExists)
+	return &lockedClass, nil
+}
+
+func (s *service) DeleteLockedClass(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindLockedClassByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("locked class not found", slog.String("package", "lockedclassservice"))
+			return errors.New("locked class not found")
+		}
+		slog.Error("error to search locked class by id", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	err = s.repo.DeleteLockedClass(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete locked class", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) FindManyLockedClassesByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyLockedClassesResponse, error) {
+	findManyLockedClasses, err := s.repo.FindManyLockedClassesByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		slog.Error("error to find many locked classes", "err", err, slog.String("package", "lockedclassservice"))
+		return nil, err
+	}
+
+	lockedClasses := response.ManyLockedClassesResponse{}
+	for _, lockedClassEntity := range findManyLockedClasses {
+		lockedClasses.LockedClasses = append(lockedClasses.LockedClasses, toLockedClassResponse(lockedClassEntity))
+	}
+
+	return &lockedClasses, nil
+}
+
+func (s *service) checkDisciplineCourse(ctx context.Context, lockedClass entity.LockedClassEntity) error {
+	belongs, err := s.repo.IsDisciplineOfParameterizationCourse(ctx, lockedClass.ParameterizationID, lockedClass.DisciplineID)
+	if err != nil {
+		slog.Error("error to check discipline course", "err", err, slog.String("package", "lockedclassservice"))
+		return err
+	}
+
+	if !belongs {
+		slog.Error("discipline does not belong to the parameterization course", slog.String("package", "lockedclassservice"))
+		return errors.New("discipline does not belong to the parameterization course")
+	}
+
+	return nil
+}
+
+// validateLockedClass checks that the class ends after it starts, that the other locked
+// classes of its section have the same professor and that it does not overlap a locked
+// class of the same professor, room or section.
+func validateLockedClass(lockedClass entity.LockedClassEntity, lockedClasses []entity.LockedClassEntity) error {
+	if !lockedClass.EndTime.After(lockedClass.StartTime) {
+		slog.Error("end time must be after start time", slog.String("package", "lockedclassservice"))
+		return errors.New("end time must be after start time")
+	}
+
+	for _, other := range lockedClasses {
+		if other.UUID == lockedClass.UUID {
+			continue
+		}
+		sameSection := other.DisciplineID == lockedClass.DisciplineID && other.Section == lockedClass.Section
+		if sameSection && other.ProfessorID != lockedClass.ProfessorID {
+			slog.Error("locked classes of a section must have the same professor", slog.String("package", "lockedclassservice"))
+			return errors.New("locked classes of a section must have the same professor")
+		}
+
+		if other.DayOfWeek != lockedClass.DayOfWeek || !lockedClass.StartTime.Before(other.EndTime) || !other.StartTime.Before(lockedClass.EndTime) {
+			continue
+		}
+		if sameSection || other.ProfessorID == lockedClass.ProfessorID || (lockedClass.RoomID != 0 && other.RoomID == lockedClass.RoomID) {
+			slog.Error("locked class overlaps another one", slog.String("package", "lockedclassservice"))
+			return errors.New("locked class overlaps another one")
+		}
+	}
+
+	return nil
+}
+
+// timeOfDay keeps only the time of day of a class, as locked classes store it.
+func timeOfDay(t time.Time) time.Time {
+	return time.Date(0, 1, 1, t.Hour(), t.Minute(), 0, 0, time.UTC)
+}
+
+func toLockedClassResponse(lockedClass entity.LockedClassEntity) response.LockedClassResponse {
+	return response.LockedClassResponse{
+		Id:                 lockedClass.ID,
+		UUID:               lockedClass.UUID.String(),
+		ParameterizationId: lockedClass.ParameterizationID,
+		DisciplineId:       lockedClass.DisciplineID,
+		ProfessorId:        lockedClass.ProfessorID,
+		RoomId:             lockedClass.RoomID,
+		Section:            lockedClass.Section,
+		DayOfWeek:          lockedClass.DayOfWeek,
+		StartTime:          lockedClass.StartTime.Format(timeOfDayLayout),
+		EndTime:            lockedClass.EndTime.Format(timeOfDayLayout),
+	}
+}
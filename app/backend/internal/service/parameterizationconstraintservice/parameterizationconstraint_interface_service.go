@@ -0,0 +1,28 @@
+package parameterizationconstraintservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationconstraintrepository"
+)
+
+func NewParameterizationConstraintService(repo parameterizationconstraintrepository.ParameterizationConstraintRepository) ParameterizationConstraintService {
+	return &service{
+		repo,
+	}
+}
+
+type service struct {
+	repo parameterizationconstraintrepository.ParameterizationConstraintRepository
+}
+
+type ParameterizationConstraintService interface {
+	CreateParameterizationConstraint(ctx context.Context, u dto.CreateParameterizationConstraintDto) error
+	UpdateParameterizationConstraint(ctx context.Context, u dto.UpdateParameterizationConstraintDto, uuid uuid.UUID) error
+	GetParameterizationConstraintByID(ctx context.Context, uuid uuid.UUID) (*response.ParameterizationConstraintResponse, error)
+	DeleteParameterizationConstraint(ctx context.Context, uuid uuid.UUID) error
+	FindManyParameterizationConstraintsByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyParameterizationConstraintsResponse, error)
+	FindManyConstraints(ctx context.Context) (*response.ManyConstraintsResponse, error)
+}
@@ -0,0 +1,158 @@
+package parameterizationconstraintservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+)
+
+func (s *service) CreateParameterizationConstraint(ctx context.Context, u dto.CreateParameterizationConstraintDto) error {
+	if _, ok := process.FindConstraint(u.Name); !ok {
+		slog.Error("constraint not registered", slog.String("name", u.Name), slog.String("package", "parameterizationconstraintservice"))
+		return errors.New("constraint not registered")
+	}
+
+	parameterizationConstraintExists, err := s.repo.FindParameterizationConstraintByName(ctx, u.ParameterizationId, u.Name)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search parameterization constraint", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return err
+	}
+
+	if parameterizationConstraintExists != nil {
+		slog.Error("constraint already configured in this parameterization", slog.String("package", "parameterizationconstraintservice"))
+		return errors.New("constraint already configured in this parameterization")
+	}
+
+	// a constraint is configured to change its weight unless it is explicitly disabled
+	enabled := true
+	if u.Enabled != nil {
+		enabled = *u.Enabled
+	}
+
+	newParameterizationConstraint := entity.ParameterizationConstraintEntity{
+		UUID:               uuid.New(),
+		ParameterizationID: u.ParameterizationId,
+		Name:               u.Name,
+		Enabled:            enabled,
+		Weight:             u.Weight,
+	}
+
+	err = s.repo.CreateParameterizationConstraint(ctx, &newParameterizationConstraint)
+	if err != nil {
+		slog.Error("error to create parameterization constraint", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateParameterizationConstraint(ctx context.Context, u dto.UpdateParameterizationConstraintDto, uuid uuid.UUID) error {
+	parameterizationConstraintExists, err := s.repo.FindParameterizationConstraintByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("parameterization constraint not found", slog.String("package", "parameterizationconstraintservice"))
+			return errors.New("parameterization constraint not found")
+		}
+		slog.Error("error to search parameterization constraint by id", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return err
+	}
+
+	// only the fields sent in the body are changed, a weight of 0 going back to the default one
+	if u.Enabled != nil {
+		parameterizationConstraintExists.Enabled = *u.Enabled
+	}
+	if u.Weight != nil {
+		parameterizationConstraintExists.Weight = *u.Weight
+	}
+
+	err = s.repo.UpdateParameterizationConstraint(ctx, parameterizationConstraintExists)
+	if err != nil {
+		slog.Error("error to update parameterization constraint", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetParameterizationConstraintByID(ctx context.Context, uuid uuid.UUID) (*response.ParameterizationConstraintResponse, error) {
+	parameterizationConstraintExists, err := s.repo.FindParameterizationConstraintByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("parameterization constraint not found", slog.String("package", "parameterizationconstraintservice"))
+			return nil, errors.New("parameterization constraint not found")
+		}
+		slog.Error("error to search parameterization constraint by id", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return nil, err
+	}
+
+	parameterizationConstraint := toParameterizationConstraintResponse(*parameterizationConstraintExists)
+	return &parameterizationConstraint, nil
+}
+
+func (s *service) FindManyParameterizationConstraintsByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyParameterizationConstraintsResponse, error) {
+	findManyParameterizationConstraints, err := s.repo.FindManyParameterizationConstraintsByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		slog.Error("error to find many parameterization constraints", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return nil, err
+	}
+
+	parameterizationConstraints := response.ManyParameterizationConstraintsResponse{}
+	for _, parameterizationConstraintEntity := range findManyParameterizationConstraints {
+		parameterizationConstraints.ParameterizationConstraints = append(parameterizationConstraints.ParameterizationConstraints, toParameterizationConstraintResponse(parameterizationConstraintEntity))
+	}
+
+	return &parameterizationConstraints, nil
+}
+
+func (s *service) DeleteParameterizationConstraint(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindParameterizationConstraintByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("parameterization constraint not found", slog.String("package", "parameterizationconstraintservice"))
+			return errors.New("parameterization constraint not found")
+		}
+		slog.Error("error to search parameterization constraint by id", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return err
+	}
+
+	err = s.repo.DeleteParameterizationConstraint(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete parameterization constraint", "err", err, slog.String("package", "parameterizationconstraintservice"))
+		return err
+	}
+
+	return nil
+}
+
+// FindManyConstraints lists the registered constraints with the weight they have when
+// a parameterization does not configure one.
+func (s *service) FindManyConstraints(ctx context.Context) (*response.ManyConstraintsResponse, error) {
+	constraints := response.ManyConstraintsResponse{}
+	for _, definition := range process.RegisteredConstraints() {
+		constraints.Constraints = append(constraints.Constraints, response.ConstraintResponse{
+			Name:          definition.Name,
+			Description:   definition.Description,
+			Hard:          definition.Hard,
+			DefaultWeight: definition.DefaultWeight(entity.ParameterizationEntity{}),
+		})
+	}
+
+	return &constraints, nil
+}
+
+func toParameterizationConstraintResponse(pc entity.ParameterizationConstraintEntity) response.ParameterizationConstraintResponse {
+	return response.ParameterizationConstraintResponse{
+		Id:                 pc.ID,
+		UUID:               pc.UUID.String(),
+		ParameterizationId: pc.ParameterizationID,
+		Name:               pc.Name,
+		Enabled:            pc.Enabled,
+		Weight:             pc.Weight,
+	}
+}
@@ -0,0 +1,27 @@
+package parameterizationdisciplineservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationdisciplinerepository"
+)
+
+func NewParameterizationDisciplineService(repo parameterizationdisciplinerepository.ParameterizationDisciplineRepository) ParameterizationDisciplineService {
+	return &service{
+		repo,
+	}
+}
+
+type service struct {
+	repo parameterizationdisciplinerepository.ParameterizationDisciplineRepository
+}
+
+type ParameterizationDisciplineService interface {
+	CreateParameterizationDiscipline(ctx context.Context, u dto.CreateParameterizationDisciplineDto) error
+	UpdateParameterizationDiscipline(ctx context.Context, u dto.UpdateParameterizationDisciplineDto, uuid uuid.UUID) error
+	GetParameterizationDisciplineByID(ctx context.Context, uuid uuid.UUID) (*response.ParameterizationDisciplineResponse, error)
+	DeleteParameterizationDiscipline(ctx context.Context, uuid uuid.UUID) error
+	FindManyParameterizationDisciplinesByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyParameterizationDisciplinesResponse, error)
+}
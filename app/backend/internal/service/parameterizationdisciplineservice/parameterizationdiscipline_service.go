@@ -0,0 +1,141 @@
+package parameterizationdisciplineservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+)
+
+func (s *service) CreateParameterizationDiscipline(ctx context.Context, u dto.CreateParameterizationDisciplineDto) error {
+	belongs, err := s.repo.IsDisciplineOfParameterizationCourse(ctx, u.ParameterizationId, u.DisciplineId)
+	if err != nil {
+		slog.Error("error to check discipline course", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return err
+	}
+
+	if !belongs {
+		slog.Error("discipline does not belong to the parameterization course", slog.String("package", "parameterizationdisciplineservice"))
+		return errors.New("discipline does not belong to the parameterization course")
+	}
+
+	parameterizationDisciplineExists, err := s.repo.FindParameterizationDisciplineByDisciplineId(ctx, u.ParameterizationId, u.DisciplineId)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search parameterization discipline", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return err
+	}
+
+	if parameterizationDisciplineExists != nil {
+		slog.Error("discipline already offered in this parameterization", slog.String("package", "parameterizationdisciplineservice"))
+		return errors.New("discipline already offered in this parameterization")
+	}
+
+	newParameterizationDiscipline := entity.ParameterizationDisciplineEntity{
+		UUID:               uuid.New(),
+		ParameterizationID: u.ParameterizationId,
+		DisciplineID:       u.DisciplineId,
+		Priority:           u.Priority,
+		Mandatory:          u.Mandatory,
+	}
+
+	err = s.repo.CreateParameterizationDiscipline(ctx, &newParameterizationDiscipline)
+	if err != nil {
+		slog.Error("error to create parameterization discipline", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateParameterizationDiscipline(ctx context.Context, u dto.UpdateParameterizationDisciplineDto, uuid uuid.UUID) error {
+	parameterizationDisciplineExists, err := s.repo.FindParameterizationDisciplineByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("parameterization discipline not found", slog.String("package", "parameterizationdisciplineservice"))
+			return errors.New("parameterization discipline not found")
+		}
+		slog.Error("error to search parameterization discipline by id", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return err
+	}
+
+	// only the fields sent in the body are changed
+	if u.Priority != nil {
+		parameterizationDisciplineExists.Priority = *u.Priority
+	}
+	if u.Mandatory != nil {
+		parameterizationDisciplineExists.Mandatory = *u.Mandatory
+	}
+
+	err = s.repo.UpdateParameterizationDiscipline(ctx, parameterizationDisciplineExists)
+	if err != nil {
+		slog.Error("error to update parameterization discipline", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetParameterizationDisciplineByID(ctx context.Context, uuid uuid.UUID) (*response.ParameterizationDisciplineResponse, error) {
+	parameterizationDisciplineExists, err := s.repo.FindParameterizationDisciplineByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("parameterization discipline not found", slog.String("package", "parameterizationdisciplineservice"))
+			return nil, errors.New("parameterization discipline not found")
+		}
+		slog.Error("error to search parameterization discipline by id", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return nil, err
+	}
+
+	parameterizationDiscipline := toParameterizationDisciplineResponse(*parameterizationDisciplineExists)
+	return &parameterizationDiscipline, nil
+}
+
+func (s *service) FindManyParameterizationDisciplinesByParameterizationId(ctx context.Context, parameterizationId int64) (*response.ManyParameterizationDisciplinesResponse, error) {
+	findManyParameterizationDisciplines, err := s.repo.FindManyParameterizationDisciplinesByParameterizationId(ctx, parameterizationId)
+	if err != nil {
+		slog.Error("error to find many parameterization disciplines", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return nil, err
+	}
+
+	parameterizationDisciplines := response.ManyParameterizationDisciplinesResponse{}
+	for _, parameterizationDisciplineEntity := range findManyParameterizationDisciplines {
+		parameterizationDisciplines.ParameterizationDisciplines = append(parameterizationDisciplines.ParameterizationDisciplines, toParameterizationDisciplineResponse(parameterizationDisciplineEntity))
+	}
+
+	return &parameterizationDisciplines, nil
+}
+
+func (s *service) DeleteParameterizationDiscipline(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindParameterizationDisciplineByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("parameterization discipline not found", slog.String("package", "parameterizationdisciplineservice"))
+			return errors.New("parameterization discipline not found")
+		}
+		slog.Error("error to search parameterization discipline by id", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return err
+	}
+
+	err = s.repo.DeleteParameterizationDiscipline(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete parameterization discipline", "err", err, slog.String("package", "parameterizationdisciplineservice"))
+		return err
+	}
+
+	return nil
+}
+
+func toParameterizationDisciplineResponse(pd entity.ParameterizationDisciplineEntity) response.ParameterizationDisciplineResponse {
+	return response.ParameterizationDisciplineResponse{
+		Id:                 pd.ID,
+		UUID:               pd.UUID.String(),
+		ParameterizationId: pd.ParameterizationID,
+		DisciplineId:       pd.DisciplineID,
+		Priority:           pd.Priority,
+		Mandatory:          pd.Mandatory,
+	}
+}
@@ -19,9 +19,24 @@ func (s *service) CreateParameterization(ctx context.Context, u dto.CreateParame
 		NumClassesPerDiscipline: u.NumClassesPerDiscipline,
 		SemesterID:              u.SemesterId,
 		CourseID:                u.CourseId,
+		PopulationSize:          valueOrDefault(u.PopulationSize, entity.DefaultPopulationSize),
+		Generations:             valueOrDefault(u.Generations, entity.DefaultGenerations),
+		TournamentSize:          valueOrDefault(u.TournamentSize, entity.DefaultTournamentSize),
+		MutationRate:            pointerOrDefault(u.MutationRate, entity.DefaultMutationRate),
+		HoursPerCredit:          valueOrDefault(u.HoursPerCredit, entity.DefaultHoursPerCredit),
+		MaxBlockHours:           valueOrDefault(u.MaxBlockHours, entity.DefaultMaxBlockHours),
+		SpreadWeight:            pointerOrDefault(u.SpreadWeight, entity.DefaultSpreadWeight),
+		SameDayWeight:           pointerOrDefault(u.SameDayWeight, entity.DefaultSameDayWeight),
+		IdleGapWeight:           pointerOrDefault(u.IdleGapWeight, entity.DefaultIdleGapWeight),
+	}
+
+	err := validateHyperparameters(newParameterization)
+	if err != nil {
+		slog.Error("invalid hyperparameters", "err", err, slog.String("package", "parameterizationservice"))
+		return err
 	}
 
-	err := s.repo.CreateParameterization(ctx, &newParameterization)
+	err = s.repo.CreateParameterization(ctx, &newParameterization)
 	if err != nil {
 		slog.Error("error to create parameterization", "err", err, slog.String("package", "parameterizationservice"))
 		return err
@@ -50,6 +65,25 @@ func (s *service) UpdateParameterization(ctx context.Context, u dto.UpdateParame
 		UUID:                    uuid,
 		MaxCreditsToOffer:       u.MaxCreditsToOffer,
 		NumClassesPerDiscipline: u.NumClassesPerDiscipline,
+		PopulationSize:          u.PopulationSize,
+		Generations:             u.Generations,
+		TournamentSize:          u.TournamentSize,
+		MutationRate:            pointerOrDefault(u.MutationRate, parameterizationExists.MutationRate),
+		HoursPerCredit:          u.HoursPerCredit,
+		MaxBlockHours:           u.MaxBlockHours,
+		SpreadWeight:            pointerOrDefault(u.SpreadWeight, parameterizationExists.SpreadWeight),
+		SameDayWeight:           pointerOrDefault(u.SameDayWeight, parameterizationExists.SameDayWeight),
+		IdleGapWeight:           pointerOrDefault(u.IdleGapWeight, parameterizationExists.IdleGapWeight),
+	}
+
+	// the update only touches the informed hyperparameters, so validate them merged with the stored ones
+	merged := *parameterizationExists
+	merged.PopulationSize = valueOrDefault(u.PopulationSize, parameterizationExists.PopulationSize)
+	merged.TournamentSize = valueOrDefault(u.TournamentSize, parameterizationExists.TournamentSize)
+	err = validateHyperparameters(merged)
+	if err != nil {
+		slog.Error("invalid hyperparameters", "err", err, slog.String("package", "parameterizationservice"))
+		return err
 	}
 
 	err = s.repo.UpdateParameterization(ctx, &updateParameterization)
@@ -79,6 +113,15 @@ func (s *service) GetParameterizationByID(ctx context.Context, uuid uuid.UUID) (
 		NumClassesPerDiscipline: parameterizationExists.NumClassesPerDiscipline,
 		SemesterId:              parameterizationExists.SemesterID,
 		CourseId:                parameterizationExists.CourseID,
+		PopulationSize:          parameterizationExists.PopulationSize,
+		Generations:             parameterizationExists.Generations,
+		TournamentSize:          parameterizationExists.TournamentSize,
+		MutationRate:            parameterizationExists.MutationRate,
+		HoursPerCredit:          parameterizationExists.HoursPerCredit,
+		MaxBlockHours:           parameterizationExists.MaxBlockHours,
+		SpreadWeight:            parameterizationExists.SpreadWeight,
+		SameDayWeight:           parameterizationExists.SameDayWeight,
+		IdleGapWeight:           parameterizationExists.IdleGapWeight,
 	}
 
 	return &parameterization, nil
@@ -100,6 +143,15 @@ func (s *service) FindManyParameterizations(ctx context.Context) (*response.Many
 			NumClassesPerDiscipline: parameterizationEntity.NumClassesPerDiscipline,
 			SemesterId:              parameterizationEntity.SemesterID,
 			CourseId:                parameterizationEntity.CourseID,
+			PopulationSize:          parameterizationEntity.PopulationSize,
+			Generations:             parameterizationEntity.Generations,
+			TournamentSize:          parameterizationEntity.TournamentSize,
+			MutationRate:            parameterizationEntity.MutationRate,
+			HoursPerCredit:          parameterizationEntity.HoursPerCredit,
+			MaxBlockHours:           parameterizationEntity.MaxBlockHours,
+			SpreadWeight:            parameterizationEntity.SpreadWeight,
+			SameDayWeight:           parameterizationEntity.SameDayWeight,
+			IdleGapWeight:           parameterizationEntity.IdleGapWeight,
 		}
 		parameterizations.Parameterizations = append(parameterizations.Parameterizations, parameterizationResponse)
 	}
@@ -123,6 +175,15 @@ func (s *service) FindManyParameterizationsBySemesterId(ctx context.Context, cou
 			NumClassesPerDiscipline: parameterizationEntity.NumClassesPerDiscipline,
 			SemesterId:              parameterizationEntity.SemesterID,
 			CourseId:                parameterizationEntity.CourseID,
+			PopulationSize:          parameterizationEntity.PopulationSize,
+			Generations:             parameterizationEntity.Generations,
+			TournamentSize:          parameterizationEntity.TournamentSize,
+			MutationRate:            parameterizationEntity.MutationRate,
+			HoursPerCredit:          parameterizationEntity.HoursPerCredit,
+			MaxBlockHours:           parameterizationEntity.MaxBlockHours,
+			SpreadWeight:            parameterizationEntity.SpreadWeight,
+			SameDayWeight:           parameterizationEntity.SameDayWeight,
+			IdleGapWeight:           parameterizationEntity.IdleGapWeight,
 		}
 		parameterizationsBySemester.Parameterizations = append(parameterizationsBySemester.Parameterizations, parameterizationResponse)
 	}
@@ -150,3 +211,27 @@ func (s *service) DeleteParameterization(ctx context.Context, uuid uuid.UUID) er
 
 	return nil
 }
+
+func validateHyperparameters(p entity.ParameterizationEntity) error {
+	if p.TournamentSize > p.PopulationSize {
+		return errors.New("tournamentSize must not be greater than populationSize")
+	}
+	return nil
+}
+
+func valueOrDefault[T int32 | float64](value, defaultValue T) T {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// pointerOrDefault is valueOrDefault for fields where 0 is a value of its own, such as a
+// mutation rate of 0 turning mutation off or a weight of 0 leaving a criterion out: only a
+// field left out takes the default.
+func pointerOrDefault[T int32 | float64](value *T, defaultValue T) T {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
@@ -0,0 +1,71 @@
+package proposalservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"time"
+)
+
+// GetProposalMeetings expands the weekly classes of the proposal into the dated
+// meetings of the whole semester, skipping its holidays.
+func (s *service) GetProposalMeetings(ctx context.Context, uuid uuid.UUID) (*response.ProposalMeetingsResponse, error) {
+	proposal, err := s.repo.FindProposalByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	semester, err := s.semesterCalendar(ctx, proposal.SemesterID)
+	if err != nil {
+		slog.Error("error to search semester of proposal", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	if semester.StartDate.IsZero() {
+		slog.Error("semester has no start and end dates", slog.String("package", "proposalservice"))
+		return nil, errors.New("semester has no start and end dates")
+	}
+
+	classes, err := s.repo.FindClassesByProposalID(ctx, proposal.ID)
+	if err != nil {
+		slog.Error("error to find classes of proposal", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	meetings := response.ProposalMeetingsResponse{
+		ProposalUUID: proposal.UUID.String(),
+		SemesterId:   semester.ID,
+		StartDate:    semester.StartDate.Format(time.DateOnly),
+		EndDate:      semester.EndDate.Format(time.DateOnly),
+	}
+	for _, meeting := range process.SemesterMeetings(classes, *semester) {
+		meetings.Meetings = append(meetings.Meetings, toClassDTO(meeting))
+	}
+
+	return &meetings, nil
+}
+
+// semesterCalendar returns the semester with its dates and holidays.
+func (s *service) semesterCalendar(ctx context.Context, semesterId int64) (*entity.SemesterEntity, error) {
+	semester, err := s.semesterRepo.FindSemesterBySemesterId(ctx, semesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	semester.Holidays, err = s.semesterRepo.FindManySemesterHolidaysBySemesterId(ctx, semester.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return semester, nil
+}
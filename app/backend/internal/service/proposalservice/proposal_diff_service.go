@@ -0,0 +1,223 @@
+package proposalservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"sort"
+)
+
+// timeOfDayLayout is how the start and end of classes are compared.
+const timeOfDayLayout = "15:04"
+
+// classKey identifies the section a class belongs to, which is what classes of two
+// proposals have in common.
+type classKey struct {
+	disciplineID int64
+	section      int32
+}
+
+// CompareProposals diffs the other proposal against the base one. The classes of a
+// section are paired in the order they meet in the week; the ones left without a pair
+// were added or removed, and paired ones that differ are listed with what changed.
+func (s *service) CompareProposals(ctx context.Context, baseUUID, otherUUID uuid.UUID) (*response.ProposalDiffResponse, error) {
+	base, baseClasses, err := s.findProposalWithClasses(ctx, baseUUID)
+	if err != nil {
+		return nil, err
+	}
+	other, otherClasses, err := s.findProposalWithClasses(ctx, otherUUID)
+	if err != nil {
+		return nil, err
+	}
+	baseGrid, err := s.timeGrid(ctx, base.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	otherGrid, err := s.timeGrid(ctx, other.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffClasses(toProposalDiffSummaryResponse(*base, baseClasses), toProposalDiffSummaryResponse(*other, otherClasses), baseClasses, otherClasses, baseGrid, otherGrid), nil
+}
+
+// diffClasses diffs the other classes against the base ones, which may be those of two
+// proposals or of two versions of one. Professor hours are counted in periods of the grid
+// of the course of each side.
+func diffClasses(base, other response.ProposalDiffSummaryResponse, baseClasses, otherClasses []entity.ClassEntity, baseGrid, otherGrid []entity.TimeSlotEntity) *response.ProposalDiffResponse {
+	diff := response.ProposalDiffResponse{
+		Base:           base,
+		Other:          other,
+		AddedClasses:   []dto.ClassDTO{},
+		RemovedClasses: []dto.ClassDTO{},
+		ChangedClasses: []response.ClassChangeResponse{},
+	}
+
+	baseSections := groupClasses(baseClasses)
+	otherSections := groupClasses(otherClasses)
+	for _, key := range sectionKeys(baseClasses, otherClasses) {
+		baseSection, otherSection := baseSections[key], otherSections[key]
+		for i := 0; i < max(len(baseSection), len(otherSection)); i++ {
+			switch {
+			case i >= len(otherSection):
+				diff.RemovedClasses = append(diff.RemovedClasses, toClassDTO(baseSection[i]))
+			case i >= len(baseSection):
+				diff.AddedClasses = append(diff.AddedClasses, toClassDTO(otherSection[i]))
+			default:
+				if changes := classChanges(baseSection[i], otherSection[i]); len(changes) > 0 {
+					diff.ChangedClasses = append(diff.ChangedClasses, response.ClassChangeResponse{
+						Changes: changes,
+						Base:    toClassDTO(baseSection[i]),
+						Other:   toClassDTO(otherSection[i]),
+					})
+				}
+			}
+		}
+	}
+
+	diff.ProfessorHours = professorHoursDeltas(baseClasses, otherClasses, baseGrid, otherGrid)
+
+	return &diff
+}
+
+func (s *service) findProposalWithClasses(ctx context.Context, uuid uuid.UUID) (*entity.ProposalEntity, []entity.ClassEntity, error) {
+	proposal, err := s.repo.FindProposalByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, nil, err
+	}
+
+	classes, err := s.repo.FindClassesByProposalID(ctx, proposal.ID)
+	if err != nil {
+		slog.Error("error to find classes of proposal", "err", err, slog.String("package", "proposalservice"))
+		return nil, nil, err
+	}
+
+	return proposal, classes, nil
+}
+
+// groupClasses splits the classes by section, keeping them in the order they meet.
+func groupClasses(classes []entity.ClassEntity) map[classKey][]entity.ClassEntity {
+	sections := make(map[classKey][]entity.ClassEntity)
+	for _, class := range classes {
+		key := classKey{disciplineID: class.DisciplineID, section: class.Section}
+		sections[key] = append(sections[key], class)
+	}
+	for _, section := range sections {
+		sort.SliceStable(section, func(i, j int) bool {
+			return section[i].StartTime.Before(section[j].StartTime)
+		})
+	}
+	return sections
+}
+
+// sectionKeys lists the sections of both proposals, the ones of the base first.
+func sectionKeys(baseClasses, otherClasses []entity.ClassEntity) []classKey {
+	var keys []classKey
+	seen := make(map[classKey]bool)
+	for _, class := range append(baseClasses[:len(baseClasses):len(baseClasses)], otherClasses...) {
+		key := classKey{disciplineID: class.DisciplineID, section: class.Section}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// classChanges names what differs between two classes of the same section. Times are
+// compared by time of day, as proposals may be dated in different weeks.
+func classChanges(base, other entity.ClassEntity) []string {
+	var changes []string
+	if base.ProfessorID != other.ProfessorID {
+		changes = append(changes, "professor")
+	}
+	if base.DayOfWeek != other.DayOfWeek {
+		changes = append(changes, "day")
+	}
+	if base.Shift != other.Shift {
+		changes = append(changes, "shift")
+	}
+	if base.StartTime.Format(timeOfDayLayout) != other.StartTime.Format(timeOfDayLayout) || base.EndTime.Format(timeOfDayLayout) != other.EndTime.Format(timeOfDayLayout) {
+		changes = append(changes, "time")
+	}
+	if base.RoomID != other.RoomID {
+		changes = append(changes, "room")
+	}
+	return changes
+}
+
+// professorHoursDeltas adds up the weekly hours of every professor teaching in either
+// proposal, in periods of the grid of each, ordered by name.
+func professorHoursDeltas(baseClasses, otherClasses []entity.ClassEntity, baseGrid, otherGrid []entity.TimeSlotEntity) []response.ProfessorHoursDeltaResponse {
+	deltas := make(map[int64]*response.ProfessorHoursDeltaResponse)
+	var professors []*response.ProfessorHoursDeltaResponse
+	hoursOf := func(class entity.ClassEntity) *response.ProfessorHoursDeltaResponse {
+		delta, ok := deltas[class.ProfessorID]
+		if !ok {
+			delta = &response.ProfessorHoursDeltaResponse{ProfessorId: class.ProfessorID}
+			if class.Professor != nil {
+				delta.ProfessorUUID = class.Professor.UUID.String()
+				delta.Name = class.Professor.Name
+			}
+			deltas[class.ProfessorID] = delta
+			professors = append(professors, delta)
+		}
+		return delta
+	}
+	for _, class := range baseClasses {
+		hoursOf(class).BaseHours += process.ClassHours(class, baseGrid)
+	}
+	for _, class := range otherClasses {
+		hoursOf(class).OtherHours += process.ClassHours(class, otherGrid)
+	}
+
+	sort.SliceStable(professors, func(i, j int) bool {
+		return professors[i].Name < professors[j].Name
+	})
+	res := []response.ProfessorHoursDeltaResponse{}
+	for _, professor := range professors {
+		professor.Delta = professor.OtherHours - professor.BaseHours
+		res = append(res, *professor)
+	}
+	return res
+}
+
+// toProposalDiffSummaryResponse sums up a proposal: its fitness and violations as
+// scored when it was saved, and the credits of the disciplines it offers, each counted
+// once however many sections it has.
+func toProposalDiffSummaryResponse(proposal entity.ProposalEntity, classes []entity.ClassEntity) response.ProposalDiffSummaryResponse {
+	summary := response.ProposalDiffSummaryResponse{
+		ProposalUUID:     proposal.UUID.String(),
+		Fitness:          proposal.Fitness,
+		Classes:          len(classes),
+		FitnessBreakdown: toProposalDTO(proposal).FitnessBreakdown,
+	}
+
+	offered := make(map[int64]bool)
+	for _, class := range classes {
+		if !offered[class.DisciplineID] && class.Discipline != nil {
+			offered[class.DisciplineID] = true
+			summary.Credits += class.Discipline.Credits
+		}
+	}
+
+	for _, score := range proposal.FitnessBreakdown {
+		if score.Hard {
+			summary.HardViolations += score.Violations
+		} else {
+			summary.SoftViolations += score.Violations
+		}
+	}
+	return summary
+}
@@ -0,0 +1,106 @@
+package proposalservice
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/common/xlsx"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"log/slog"
+)
+
+func (s *service) ExportProposal(ctx context.Context, uuid uuid.UUID) ([]byte, error) {
+	summary, err := s.repo.FindProposalSummaryByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	return s.offeringWorkbook(ctx, []entity.ProposalSummaryEntity{*summary})
+}
+
+// ExportSemesterOffering exports a proposal of every course in the semester, the approved or
+// published one when there is one and else the latest.
+func (s *service) ExportSemesterOffering(ctx context.Context, semesterId int64) ([]byte, error) {
+	summaries, err := s.repo.FindLatestProposalSummariesBySemesterId(ctx, semesterId)
+	if err != nil {
+		slog.Error("error to find proposals of semester", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	if len(summaries) == 0 {
+		slog.Error("no proposals found for semester", slog.String("package", "proposalservice"))
+		return nil, errors.New("no proposals found for this semester")
+	}
+
+	return s.offeringWorkbook(ctx, summaries)
+}
+
+// offeringWorkbook builds a spreadsheet with a summary sheet comparing the credits
+// offered against the parameterized maximum, followed by one sheet per course.
+func (s *service) offeringWorkbook(ctx context.Context, summaries []entity.ProposalSummaryEntity) ([]byte, error) {
+	workbook := xlsx.NewWorkbook()
+	summarySheet := workbook.AddSheet("Summary")
+	summarySheet.AddHeader("Course", "Semester", "Disciplines offered", "Credits offered", "Max credits to offer", "Remaining credits")
+
+	for _, summary := range summaries {
+		classes, err := s.repo.FindClassesByProposalID(ctx, summary.ID)
+		if err != nil {
+			slog.Error("error to find classes of proposal", "err", err, slog.String("package", "proposalservice"))
+			return nil, err
+		}
+
+		sheet := workbook.AddSheet(summary.CourseName)
+		sheet.AddHeader("Code", "Discipline", "Section", "Credits", "Professor", "Day", "Shift", "Start time", "End time", "Room")
+
+		offered := make(map[int64]bool)
+		var creditsOffered int32
+		for _, class := range classes {
+			room := ""
+			if class.Room != nil {
+				room = class.Room.Name
+			}
+			sheet.AddRow(class.Discipline.Code, class.Discipline.Name, sectionLabel(class.Section), class.Discipline.Credits, class.Professor.Name,
+				class.DayOfWeek, class.Shift, class.StartTime.Format("15:04"), class.EndTime.Format("15:04"), room)
+			if !offered[class.DisciplineID] {
+				offered[class.DisciplineID] = true
+				creditsOffered += class.Discipline.Credits
+			}
+		}
+
+		// proposals generated before the parameterization was linked have no maximum to compare against
+		var maxCredits, remaining interface{}
+		if summary.MaxCreditsToOffer > 0 {
+			maxCredits = summary.MaxCreditsToOffer
+			remaining = summary.MaxCreditsToOffer - creditsOffered
+		}
+		summarySheet.AddRow(summary.CourseName, summary.SemesterName, len(offered), creditsOffered, maxCredits, remaining)
+	}
+
+	var buf bytes.Buffer
+	err := workbook.Write(&buf)
+	if err != nil {
+		slog.Error("error to write spreadsheet", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sectionLabel names a class section the way the secretariat does: 1 -> "Turma A", 2 -> "Turma B".
+func sectionLabel(section int32) string {
+	if section < 1 {
+		section = 1
+	}
+	label := ""
+	for n := int(section); n > 0; n = (n - 1) / 26 {
+		label = string(rune('A'+(n-1)%26)) + label
+	}
+	return "Turma " + label
+}
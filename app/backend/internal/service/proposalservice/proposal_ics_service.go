@@ -0,0 +1,114 @@
+package proposalservice
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/common/ics"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"log/slog"
+	"strings"
+)
+
+// icsProductID identifies the application in the calendars it writes.
+const icsProductID = "-//time-table-project//timetable//EN"
+
+// ExportProposalCalendar writes the classes of the proposal as an iCalendar file, each
+// class a weekly event over the semester dates that skips the holidays. With a
+// professor only their classes are written, otherwise all the classes of the course.
+// Events are identified by the uuid of their class, so importing the file again
+// updates them instead of adding new ones.
+func (s *service) ExportProposalCalendar(ctx context.Context, proposalUUID, professorUUID uuid.UUID) ([]byte, error) {
+	summary, err := s.repo.FindProposalSummaryByID(ctx, proposalUUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	semester, err := s.semesterCalendar(ctx, summary.SemesterID)
+	if err != nil {
+		slog.Error("error to search semester of proposal", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	if semester.StartDate.IsZero() {
+		slog.Error("semester has no start and end dates", slog.String("package", "proposalservice"))
+		return nil, errors.New("semester has no start and end dates")
+	}
+
+	classes, err := s.repo.FindClassesByProposalID(ctx, summary.ID)
+	if err != nil {
+		slog.Error("error to find classes of proposal", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	name := summary.CourseName + " - " + summary.SemesterName
+	if professorUUID != uuid.Nil {
+		professor, err := s.professorRepo.FindProfessorByID(ctx, professorUUID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				slog.Error("professor not found", slog.String("package", "proposalservice"))
+				return nil, errors.New("professor not found")
+			}
+			slog.Error("error to search professor by id", "err", err, slog.String("package", "proposalservice"))
+			return nil, err
+		}
+
+		var professorClasses []entity.ClassEntity
+		for _, class := range classes {
+			if class.ProfessorID == professor.ID {
+				professorClasses = append(professorClasses, class)
+			}
+		}
+		classes = professorClasses
+		name = professor.Name + " - " + name
+	}
+
+	calendar := ics.NewCalendar(icsProductID, name)
+	for _, recurrence := range process.SemesterRecurrences(classes, *semester) {
+		calendar.AddEvent(toClassEvent(recurrence, summary.CourseName))
+	}
+
+	var buf bytes.Buffer
+	err = calendar.Write(&buf)
+	if err != nil {
+		slog.Error("error to write calendar", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func toClassEvent(recurrence process.ClassRecurrence, courseName string) ics.Event {
+	class := recurrence.Class
+	event := ics.Event{
+		UID:     class.UUID.String() + "@time-table-project",
+		Summary: fmt.Sprintf("%s %s (%s)", class.Discipline.Code, class.Discipline.Name, sectionLabel(class.Section)),
+		Start:   class.StartTime,
+		End:     class.EndTime,
+		Until:   recurrence.Until,
+		ExDates: recurrence.Holidays,
+	}
+
+	description := []string{"Course: " + courseName}
+	if class.Professor != nil {
+		description = append(description, "Professor: "+class.Professor.Name)
+	}
+	event.Description = strings.Join(description, "\n")
+
+	if class.Room != nil {
+		event.Location = class.Room.Name
+		if class.Room.Location != "" {
+			event.Location += ", " + class.Room.Location
+		}
+	}
+	return event
+}
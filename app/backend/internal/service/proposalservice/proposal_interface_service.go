@@ -0,0 +1,52 @@
+package proposalservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/professorrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/proposalrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/semesterrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/shifthoursrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/timeslotrepository"
+)
+
+func NewProposalService(repo proposalrepository.ProposalRepository, timeSlotRepo timeslotrepository.TimeSlotRepository, shiftHoursRepo shifthoursrepository.ShiftHoursRepository, semesterRepo semesterrepository.SemesterRepository, professorRepo professorrepository.ProfessorRepository) ProposalService {
+	return &service{
+		repo,
+		timeSlotRepo,
+		shiftHoursRepo,
+		semesterRepo,
+		professorRepo,
+	}
+}
+
+type service struct {
+	repo           proposalrepository.ProposalRepository
+	timeSlotRepo   timeslotrepository.TimeSlotRepository
+	shiftHoursRepo shifthoursrepository.ShiftHoursRepository
+	semesterRepo   semesterrepository.SemesterRepository
+	professorRepo  professorrepository.ProfessorRepository
+}
+
+type ProposalService interface {
+	GetProposalByID(ctx context.Context, uuid uuid.UUID) (*dto.ProposalDTO, error)
+	FindManyProposals(ctx context.Context, semesterId, courseId int64, status string) (*response.ManyProposalsResponse, error)
+	ExportProposal(ctx context.Context, uuid uuid.UUID) ([]byte, error)
+	ExportSemesterOffering(ctx context.Context, semesterId int64) ([]byte, error)
+	GetProposalWorkload(ctx context.Context, uuid uuid.UUID, onlyMismatches bool) (*response.ManyProfessorWorkloadsResponse, error)
+	GetSemesterWorkload(ctx context.Context, semesterId int64, onlyMismatches bool) (*response.ManyProfessorWorkloadsResponse, error)
+	GetProposalMeetings(ctx context.Context, uuid uuid.UUID) (*response.ProposalMeetingsResponse, error)
+	CompareProposals(ctx context.Context, baseUUID, otherUUID uuid.UUID) (*response.ProposalDiffResponse, error)
+	TransitionProposal(ctx context.Context, proposalUUID, userUUID uuid.UUID, u dto.TransitionProposalDto) error
+	FindManyProposalTransitions(ctx context.Context, proposalUUID uuid.UUID) (*response.ManyProposalTransitionsResponse, error)
+	FindManyProposalVersions(ctx context.Context, proposalUUID uuid.UUID) (*response.ManyProposalVersionsResponse, error)
+	GetProposalVersion(ctx context.Context, proposalUUID uuid.UUID, version int32) (*response.ProposalVersionResponse, error)
+	CompareProposalVersions(ctx context.Context, proposalUUID uuid.UUID, baseVersion, otherVersion int32) (*response.ProposalDiffResponse, error)
+	RestoreProposalVersion(ctx context.Context, proposalUUID, userUUID uuid.UUID, version int32, u dto.RestoreProposalVersionDto) (*response.ProposalVersionResponse, error)
+	GetProfessorTimetable(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) (*response.ProfessorTimetableResponse, error)
+	ExportProfessorTimetable(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) ([]byte, error)
+	PrintProfessorTimetable(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) ([]byte, error)
+	ExportProposalCalendar(ctx context.Context, proposalUUID, professorUUID uuid.UUID) ([]byte, error)
+}
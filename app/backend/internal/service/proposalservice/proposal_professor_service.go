@@ -0,0 +1,327 @@
+package proposalservice
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/common/xlsx"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"html/template"
+	"log/slog"
+	"slices"
+	"sort"
+)
+
+// professorTimetable is the week of a professor in a semester: the classes they teach in
+// the proposals considered, with the course each proposal belongs to, and the grids of
+// periods of the courses their hours are counted in.
+type professorTimetable struct {
+	professor    *entity.ProfessorEntity
+	semesterName string
+	courses      map[int64]entity.ProposalSummaryEntity
+	classes      []entity.ClassEntity
+	grids        map[int64][]entity.TimeSlotEntity
+}
+
+// GetProfessorTimetable returns the classes of the professor in the semester grouped by
+// weekday and shift, with their hours checked against the hours the professor has to
+// allocate. The approved or published proposal of every course is considered, or the
+// latest one when the course has none; with approvedOnly, only the approved and
+// published ones.
+func (s *service) GetProfessorTimetable(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) (*response.ProfessorTimetableResponse, error) {
+	timetable, err := s.loadProfessorTimetable(ctx, professorUUID, semesterId, approvedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	res := response.ProfessorTimetableResponse{
+		ProfessorUUID: timetable.professor.UUID.String(),
+		Name:          timetable.professor.Name,
+		SemesterId:    semesterId,
+		SemesterName:  timetable.semesterName,
+		ApprovedOnly:  approvedOnly,
+		PlannedHours:  timetable.professor.HoursToAllocate,
+		Days:          []response.ProfessorTimetableDayResponse{},
+	}
+	res.AllocatedHours, res.Delta, res.Status = timetable.workload()
+
+	for _, day := range timetable.days() {
+		dayResponse := response.ProfessorTimetableDayResponse{DayOfWeek: day.dayOfWeek}
+		for _, shift := range day.shifts {
+			shiftResponse := response.ProfessorTimetableShiftResponse{Shift: shift.shift}
+			for _, class := range shift.classes {
+				shiftResponse.Classes = append(shiftResponse.Classes, response.ProfessorTimetableClassResponse{
+					ProposalUUID: timetable.courses[class.ProposalID].UUID.String(),
+					CourseName:   timetable.courses[class.ProposalID].CourseName,
+					Class:        toClassDTO(class),
+				})
+			}
+			dayResponse.Shifts = append(dayResponse.Shifts, shiftResponse)
+		}
+		res.Days = append(res.Days, dayResponse)
+	}
+
+	return &res, nil
+}
+
+// ExportProfessorTimetable writes the timetable of the professor as a spreadsheet with a
+// summary sheet of the workload followed by the classes of the week.
+func (s *service) ExportProfessorTimetable(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) ([]byte, error) {
+	timetable, err := s.loadProfessorTimetable(ctx, professorUUID, semesterId, approvedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	workbook := xlsx.NewWorkbook()
+	allocatedHours, delta, status := timetable.workload()
+	summarySheet := workbook.AddSheet("Summary")
+	summarySheet.AddHeader("Professor", "Semester", "Hours to allocate", "Allocated hours", "Delta", "Status")
+	summarySheet.AddRow(timetable.professor.Name, timetable.semesterName, timetable.professor.HoursToAllocate, allocatedHours, delta, status)
+
+	sheet := workbook.AddSheet("Timetable")
+	sheet.AddHeader("Day", "Shift", "Start time", "End time", "Code", "Discipline", "Section", "Course", "Room", "Hours")
+	for _, day := range timetable.days() {
+		for _, shift := range day.shifts {
+			for _, class := range shift.classes {
+				room := ""
+				if class.Room != nil {
+					room = class.Room.Name
+				}
+				sheet.AddRow(day.dayOfWeek, shift.shift, class.StartTime.Format(timeOfDayLayout), class.EndTime.Format(timeOfDayLayout),
+					class.Discipline.Code, class.Discipline.Name, sectionLabel(class.Section), timetable.courses[class.ProposalID].CourseName,
+					room, timetable.classHours(class))
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	err = workbook.Write(&buf)
+	if err != nil {
+		slog.Error("error to write spreadsheet", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PrintProfessorTimetable renders the timetable of the professor as an HTML weekly grid,
+// one column per weekday and one row per class time, ready to be printed or saved as PDF
+// from the browser.
+func (s *service) PrintProfessorTimetable(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) ([]byte, error) {
+	timetable, err := s.loadProfessorTimetable(ctx, professorUUID, semesterId, approvedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	grid := timetableGrid{
+		Professor:    timetable.professor.Name,
+		Semester:     timetable.semesterName,
+		ApprovedOnly: approvedOnly,
+		PlannedHours: timetable.professor.HoursToAllocate,
+	}
+	grid.AllocatedHours, grid.Delta, grid.Status = timetable.workload()
+
+	// a column for every weekday of the schedule, Saturday only when there are classes on it
+	for _, day := range process.ScheduleWeekdays {
+		if day != "Saturday" || slices.ContainsFunc(timetable.classes, func(class entity.ClassEntity) bool { return class.DayOfWeek == day }) {
+			grid.Days = append(grid.Days, day)
+		}
+	}
+
+	rows := make(map[string]*timetableGridRow)
+	for _, class := range timetable.classes {
+		key := class.StartTime.Format(timeOfDayLayout) + " - " + class.EndTime.Format(timeOfDayLayout)
+		row, ok := rows[key]
+		if !ok {
+			row = &timetableGridRow{Time: key, Cells: make([][]timetableGridCell, len(grid.Days))}
+			rows[key] = row
+			grid.Rows = append(grid.Rows, row)
+		}
+		column := slices.Index(grid.Days, class.DayOfWeek)
+		if column < 0 {
+			continue
+		}
+		cell := timetableGridCell{
+			Discipline: class.Discipline.Code + " " + class.Discipline.Name,
+			Section:    sectionLabel(class.Section),
+			Course:     timetable.courses[class.ProposalID].CourseName,
+		}
+		if class.Room != nil {
+			cell.Room = class.Room.Name
+		}
+		row.Cells[column] = append(row.Cells[column], cell)
+	}
+	sort.SliceStable(grid.Rows, func(i, j int) bool {
+		return grid.Rows[i].Time < grid.Rows[j].Time
+	})
+
+	var buf bytes.Buffer
+	err = timetableTemplate.Execute(&buf, grid)
+	if err != nil {
+		slog.Error("error to render timetable", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *service) loadProfessorTimetable(ctx context.Context, professorUUID uuid.UUID, semesterId int64, approvedOnly bool) (*professorTimetable, error) {
+	professor, err := s.professorRepo.FindProfessorByID(ctx, professorUUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("professor not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("professor not found")
+		}
+		slog.Error("error to search professor by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	var summaries []entity.ProposalSummaryEntity
+	if approvedOnly {
+		summaries, err = s.repo.FindApprovedProposalSummariesBySemesterId(ctx, semesterId)
+	} else {
+		summaries, err = s.repo.FindLatestProposalSummariesBySemesterId(ctx, semesterId)
+	}
+	if err != nil {
+		slog.Error("error to find proposals of semester", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	if len(summaries) == 0 {
+		slog.Error("no proposals found for semester", slog.String("package", "proposalservice"))
+		return nil, errors.New("no proposals found for this semester")
+	}
+
+	timetable := professorTimetable{
+		professor:    professor,
+		semesterName: summaries[0].SemesterName,
+		courses:      make(map[int64]entity.ProposalSummaryEntity),
+	}
+	proposalIds := make([]int64, 0, len(summaries))
+	courseIds := make([]int64, 0, len(summaries))
+	for _, summary := range summaries {
+		proposalIds = append(proposalIds, summary.ID)
+		courseIds = append(courseIds, summary.CourseID)
+		timetable.courses[summary.ID] = summary
+	}
+
+	timetable.classes, err = s.repo.FindClassesByProfessorAndProposalIds(ctx, professor.ID, proposalIds)
+	if err != nil {
+		slog.Error("error to find classes of professor", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	timetable.grids, err = s.timeGrids(ctx, courseIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &timetable, nil
+}
+
+// workload adds up the weekly hours of the classes and compares them with the hours the
+// professor has to allocate, the same way the workload report does.
+func (t *professorTimetable) workload() (float64, float64, string) {
+	var allocatedHours float64
+	for _, class := range t.classes {
+		allocatedHours += t.classHours(class)
+	}
+	delta := allocatedHours - float64(t.professor.HoursToAllocate)
+	return allocatedHours, delta, workloadStatus(delta)
+}
+
+// classHours counts the hours of the class in periods of the grid of its course.
+func (t *professorTimetable) classHours(class entity.ClassEntity) float64 {
+	return process.ClassHours(class, t.grids[t.courses[class.ProposalID].CourseID])
+}
+
+type timetableDay struct {
+	dayOfWeek string
+	shifts    []timetableShift
+}
+
+type timetableShift struct {
+	shift   string
+	classes []entity.ClassEntity
+}
+
+// days groups the classes by weekday and shift, in the order of the week and of the day.
+// Classes are already ordered by start time.
+func (t *professorTimetable) days() []timetableDay {
+	var days []timetableDay
+	for _, dayOfWeek := range process.ScheduleWeekdays {
+		day := timetableDay{dayOfWeek: dayOfWeek}
+		for _, shift := range process.Shifts {
+			var classes []entity.ClassEntity
+			for _, class := range t.classes {
+				if class.DayOfWeek == dayOfWeek && class.Shift == shift {
+					classes = append(classes, class)
+				}
+			}
+			if len(classes) > 0 {
+				day.shifts = append(day.shifts, timetableShift{shift: shift, classes: classes})
+			}
+		}
+		if len(day.shifts) > 0 {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+type timetableGrid struct {
+	Professor      string
+	Semester       string
+	ApprovedOnly   bool
+	PlannedHours   int32
+	AllocatedHours float64
+	Delta          float64
+	Status         string
+	Days           []string
+	Rows           []*timetableGridRow
+}
+
+type timetableGridRow struct {
+	Time  string
+	Cells [][]timetableGridCell
+}
+
+type timetableGridCell struct {
+	Discipline string
+	Section    string
+	Course     string
+	Room       string
+}
+
+var timetableTemplate = template.Must(template.New("timetable").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Professor}} - {{.Semester}}</title>
+<style>
+body { font-family: sans-serif; font-size: 12px; margin: 16px; }
+h1 { font-size: 18px; margin: 0 0 4px; }
+p { margin: 0 0 12px; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #999; padding: 4px; vertical-align: top; }
+th { background: #eee; }
+td.time { white-space: nowrap; font-weight: bold; }
+.class { margin-bottom: 4px; }
+.class span { display: block; color: #555; }
+@page { size: A4 landscape; margin: 10mm; }
+</style>
+</head>
+<body>
+<h1>{{.Professor}}</h1>
+<p>{{.Semester}}{{if .ApprovedOnly}} (approved proposals){{end}} - {{printf "%.1f" .AllocatedHours}} of {{.PlannedHours}} hours ({{.Status}})</p>
+<table>
+<tr><th>Time</th>{{range .Days}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr><td class="time">{{.Time}}</td>{{range .Cells}}<td>{{range .}}<div class="class"><strong>{{.Discipline}}</strong><span>{{.Section}} - {{.Course}}</span>{{if .Room}}<span>{{.Room}}</span>{{end}}</div>{{end}}</td>{{end}}</tr>
+{{end}}</table>
+</body>
+</html>
+`))
@@ -0,0 +1,132 @@
+package proposalservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"slices"
+)
+
+func (s *service) GetProposalByID(ctx context.Context, uuid uuid.UUID) (*dto.ProposalDTO, error) {
+	proposalExists, err := s.repo.FindProposalByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	classes, err := s.repo.FindClassesByProposalID(ctx, proposalExists.ID)
+	if err != nil {
+		slog.Error("error to find classes of proposal", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	proposal := toProposalDTO(*proposalExists)
+	for _, class := range classes {
+		proposal.Classes = append(proposal.Classes, toClassDTO(class))
+	}
+
+	return &proposal, nil
+}
+
+func (s *service) FindManyProposals(ctx context.Context, semesterId, courseId int64, status string) (*response.ManyProposalsResponse, error) {
+	if status != "" && !slices.Contains(proposalStatuses, status) {
+		slog.Error("invalid status", slog.String("package", "proposalservice"))
+		return nil, errors.New("invalid status")
+	}
+
+	findManyProposals, err := s.repo.FindManyProposals(ctx, semesterId, courseId, status)
+	if err != nil {
+		slog.Error("error to find many proposals", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	proposals := response.ManyProposalsResponse{}
+	for _, proposalEntity := range findManyProposals {
+		proposals.Proposals = append(proposals.Proposals, toProposalDTO(proposalEntity))
+	}
+
+	return &proposals, nil
+}
+
+func toProposalDTO(proposal entity.ProposalEntity) dto.ProposalDTO {
+	proposalDTO := dto.ProposalDTO{
+		ID:                 proposal.ID,
+		UUID:               proposal.UUID.String(),
+		SemesterID:         proposal.SemesterID,
+		CourseID:           proposal.CourseID,
+		ParameterizationID: proposal.ParameterizationID,
+		Fitness:            proposal.Fitness,
+		Seed:               proposal.Seed,
+		PopulationSize:     proposal.PopulationSize,
+		Generations:        proposal.Generations,
+		TournamentSize:     proposal.TournamentSize,
+		MutationRate:       proposal.MutationRate,
+		CreatedAt:          proposal.CreatedAt,
+		Status:             proposal.Status,
+	}
+	for _, score := range proposal.FitnessBreakdown {
+		proposalDTO.FitnessBreakdown = append(proposalDTO.FitnessBreakdown, dto.ConstraintScoreDTO{
+			Name:       score.Name,
+			Hard:       score.Hard,
+			Weight:     score.Weight,
+			Violations: score.Violations,
+			Penalty:    score.Penalty,
+		})
+	}
+	return proposalDTO
+}
+
+func toClassDTO(class entity.ClassEntity) dto.ClassDTO {
+	classDTO := dto.ClassDTO{
+		ID:         class.ID,
+		UUID:       class.UUID.String(),
+		DayOfWeek:  class.DayOfWeek,
+		Shift:      class.Shift,
+		StartTime:  class.StartTime,
+		EndTime:    class.EndTime,
+		Section:    class.Section,
+		ProposalID: class.ProposalID,
+		Locked:     class.Locked,
+	}
+	if class.Discipline != nil {
+		classDTO.Discipline = dto.DisciplineDTO{
+			ID:                class.Discipline.ID,
+			UUID:              class.Discipline.UUID.String(),
+			Name:              class.Discipline.Name,
+			Credits:           int(class.Discipline.Credits),
+			CourseID:          class.Discipline.CourseID,
+			RoomType:          class.Discipline.RoomType,
+			ExpectedEnrolment: class.Discipline.ExpectedEnrolment,
+			Term:              class.Discipline.Term,
+			Code:              class.Discipline.Code,
+		}
+	}
+	if class.Professor != nil {
+		classDTO.Professor = dto.ProfessorDTO{
+			ID:              class.Professor.ID,
+			UUID:            class.Professor.UUID.String(),
+			Name:            class.Professor.Name,
+			HoursToAllocate: int(class.Professor.HoursToAllocate),
+		}
+	}
+	if class.Room != nil {
+		classDTO.Room = &dto.RoomDTO{
+			ID:       class.Room.ID,
+			UUID:     class.Room.UUID.String(),
+			Name:     class.Room.Name,
+			Capacity: class.Room.Capacity,
+			Type:     class.Room.Type,
+			Location: class.Room.Location,
+		}
+	}
+	return classDTO
+}
@@ -0,0 +1,128 @@
+package proposalservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"slices"
+)
+
+// proposalStatuses is the lifecycle of a proposal, in order.
+var proposalStatuses = []string{
+	entity.ProposalStatusDraft,
+	entity.ProposalStatusUnderReview,
+	entity.ProposalStatusApproved,
+	entity.ProposalStatusPublished,
+	entity.ProposalStatusArchived,
+}
+
+// proposalTransitions lists the statuses a proposal may move to from each status. A
+// proposal under review may be sent back to draft and an approved one reopened for
+// review; published proposals can only be archived and archived ones never change.
+var proposalTransitions = map[string][]string{
+	entity.ProposalStatusDraft:       {entity.ProposalStatusUnderReview, entity.ProposalStatusArchived},
+	entity.ProposalStatusUnderReview: {entity.ProposalStatusDraft, entity.ProposalStatusApproved, entity.ProposalStatusArchived},
+	entity.ProposalStatusApproved:    {entity.ProposalStatusUnderReview, entity.ProposalStatusPublished, entity.ProposalStatusArchived},
+	entity.ProposalStatusPublished:   {entity.ProposalStatusArchived},
+}
+
+// TransitionProposal moves the proposal to a new status, recording who did it. A course
+// has a single approved proposal per semester, published ones included, so another one
+// can only be approved after that one is reopened or archived.
+func (s *service) TransitionProposal(ctx context.Context, proposalUUID, userUUID uuid.UUID, u dto.TransitionProposalDto) error {
+	proposal, err := s.repo.FindProposalByID(ctx, proposalUUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return err
+	}
+
+	if !slices.Contains(proposalTransitions[proposal.Status], u.Status) {
+		slog.Error("invalid status transition", slog.String("package", "proposalservice"))
+		return errors.New("invalid status transition")
+	}
+
+	if u.Status == entity.ProposalStatusApproved {
+		approved, err := s.repo.FindApprovedProposalsByCourseAndSemester(ctx, proposal.CourseID, proposal.SemesterID)
+		if err != nil {
+			slog.Error("error to find approved proposals", "err", err, slog.String("package", "proposalservice"))
+			return err
+		}
+		if len(approved) > 0 {
+			slog.Error("course already has an approved proposal for this semester", slog.String("package", "proposalservice"))
+			return errors.New("course already has an approved proposal for this semester")
+		}
+	}
+
+	transition := entity.ProposalTransitionEntity{
+		UUID:       uuid.New(),
+		ProposalID: proposal.ID,
+		FromStatus: proposal.Status,
+		ToStatus:   u.Status,
+		UserUUID:   userUUID,
+		Comment:    u.Comment,
+	}
+
+	proposal.Status = u.Status
+	err = s.repo.TransitionProposal(ctx, proposal, &transition)
+	if err != nil {
+		// another proposal of the course may have been approved since the check above
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "proposal_approved_unique_idx" {
+			slog.Error("course already has an approved proposal for this semester", slog.String("package", "proposalservice"))
+			return errors.New("course already has an approved proposal for this semester")
+		}
+		slog.Error("error to transition proposal", "err", err, slog.String("package", "proposalservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) FindManyProposalTransitions(ctx context.Context, proposalUUID uuid.UUID) (*response.ManyProposalTransitionsResponse, error) {
+	proposal, err := s.repo.FindProposalByID(ctx, proposalUUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	transitions, err := s.repo.FindManyProposalTransitionsByProposalId(ctx, proposal.ID)
+	if err != nil {
+		slog.Error("error to find proposal transitions", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	res := response.ManyProposalTransitionsResponse{
+		Status:      proposal.Status,
+		Transitions: []response.ProposalTransitionResponse{},
+	}
+	for _, transition := range transitions {
+		transitionResponse := response.ProposalTransitionResponse{
+			UUID:       transition.UUID.String(),
+			FromStatus: transition.FromStatus,
+			ToStatus:   transition.ToStatus,
+			UserName:   transition.UserName,
+			Comment:    transition.Comment,
+			CreatedAt:  transition.CreatedAt,
+		}
+		if transition.UserUUID != uuid.Nil {
+			transitionResponse.UserUUID = transition.UserUUID.String()
+		}
+		res.Transitions = append(res.Transitions, transitionResponse)
+	}
+
+	return &res, nil
+}
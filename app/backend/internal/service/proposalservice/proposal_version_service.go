@@ -0,0 +1,174 @@
+package proposalservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+)
+
+// FindManyProposalVersions lists the versions of the proposal, the latest first.
+func (s *service) FindManyProposalVersions(ctx context.Context, proposalUUID uuid.UUID) (*response.ManyProposalVersionsResponse, error) {
+	proposal, err := s.findProposal(ctx, proposalUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.repo.FindManyProposalVersionsByProposalId(ctx, proposal.ID)
+	if err != nil {
+		slog.Error("error to find proposal versions", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	res := response.ManyProposalVersionsResponse{Versions: []response.ProposalVersionResponse{}}
+	for _, version := range versions {
+		res.Versions = append(res.Versions, toProposalVersionResponse(version))
+	}
+
+	return &res, nil
+}
+
+// GetProposalVersion returns a version of the proposal with the classes it had then.
+func (s *service) GetProposalVersion(ctx context.Context, proposalUUID uuid.UUID, version int32) (*response.ProposalVersionResponse, error) {
+	_, proposalVersion, classes, err := s.findVersionWithClasses(ctx, proposalUUID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toProposalVersionResponse(*proposalVersion)
+	res.FitnessBreakdown = toProposalDTO(entity.ProposalEntity{FitnessBreakdown: proposalVersion.FitnessBreakdown}).FitnessBreakdown
+	res.Classes = []dto.ClassDTO{}
+	for _, class := range classes {
+		res.Classes = append(res.Classes, toClassDTO(class))
+	}
+
+	return &res, nil
+}
+
+// CompareProposalVersions diffs two versions of the proposal, the same way two proposals
+// are compared.
+func (s *service) CompareProposalVersions(ctx context.Context, proposalUUID uuid.UUID, baseVersion, otherVersion int32) (*response.ProposalDiffResponse, error) {
+	proposal, base, baseClasses, err := s.findVersionWithClasses(ctx, proposalUUID, baseVersion)
+	if err != nil {
+		return nil, err
+	}
+	_, other, otherClasses, err := s.findVersionWithClasses(ctx, proposalUUID, otherVersion)
+	if err != nil {
+		return nil, err
+	}
+	grid, err := s.timeGrid(ctx, proposal.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffClasses(toVersionDiffSummaryResponse(*proposal, *base, baseClasses), toVersionDiffSummaryResponse(*proposal, *other, otherClasses), baseClasses, otherClasses, grid, grid), nil
+}
+
+// RestoreProposalVersion brings back the classes of an older version. The history is
+// kept as it is: the restored classes become a new version on top of it.
+func (s *service) RestoreProposalVersion(ctx context.Context, proposalUUID, userUUID uuid.UUID, version int32, u dto.RestoreProposalVersionDto) (*response.ProposalVersionResponse, error) {
+	proposal, proposalVersion, _, err := s.findVersionWithClasses(ctx, proposalUUID, version)
+	if err != nil {
+		return nil, err
+	}
+	// the classes of approved, published and archived proposals can no longer change
+	if proposal.Status != entity.ProposalStatusDraft && proposal.Status != entity.ProposalStatusUnderReview {
+		slog.Error("proposal is read-only", slog.String("package", "proposalservice"))
+		return nil, errors.New("proposal is read-only")
+	}
+
+	restored := entity.ProposalVersionEntity{
+		UUID:             uuid.New(),
+		ProposalID:       proposal.ID,
+		UserUUID:         userUUID,
+		Reason:           u.Reason,
+		Fitness:          proposalVersion.Fitness,
+		FitnessBreakdown: proposalVersion.FitnessBreakdown,
+	}
+	if restored.Reason == "" {
+		restored.Reason = fmt.Sprintf("restored version %d", version)
+	}
+
+	err = s.repo.RestoreProposalVersion(ctx, &restored, proposalVersion)
+	if err != nil {
+		slog.Error("error to restore proposal version", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	created, err := s.repo.FindProposalVersion(ctx, proposal.ID, restored.Version)
+	if err != nil {
+		slog.Error("error to search proposal version", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	res := toProposalVersionResponse(*created)
+	return &res, nil
+}
+
+func (s *service) findProposal(ctx context.Context, proposalUUID uuid.UUID) (*entity.ProposalEntity, error) {
+	proposal, err := s.repo.FindProposalByID(ctx, proposalUUID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func (s *service) findVersionWithClasses(ctx context.Context, proposalUUID uuid.UUID, version int32) (*entity.ProposalEntity, *entity.ProposalVersionEntity, []entity.ClassEntity, error) {
+	proposal, err := s.findProposal(ctx, proposalUUID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proposalVersion, err := s.repo.FindProposalVersion(ctx, proposal.ID, version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal version not found", slog.String("package", "proposalservice"))
+			return nil, nil, nil, errors.New("proposal version not found")
+		}
+		slog.Error("error to search proposal version", "err", err, slog.String("package", "proposalservice"))
+		return nil, nil, nil, err
+	}
+
+	classes, err := s.repo.FindClassesByVersionId(ctx, proposalVersion.ID)
+	if err != nil {
+		slog.Error("error to find classes of proposal version", "err", err, slog.String("package", "proposalservice"))
+		return nil, nil, nil, err
+	}
+
+	return proposal, proposalVersion, classes, nil
+}
+
+// toVersionDiffSummaryResponse sums up a version of the proposal, scored as it was when
+// the version was saved.
+func toVersionDiffSummaryResponse(proposal entity.ProposalEntity, version entity.ProposalVersionEntity, classes []entity.ClassEntity) response.ProposalDiffSummaryResponse {
+	proposal.Fitness = version.Fitness
+	proposal.FitnessBreakdown = version.FitnessBreakdown
+	summary := toProposalDiffSummaryResponse(proposal, classes)
+	summary.Version = version.Version
+	return summary
+}
+
+func toProposalVersionResponse(version entity.ProposalVersionEntity) response.ProposalVersionResponse {
+	res := response.ProposalVersionResponse{
+		UUID:      version.UUID.String(),
+		Version:   version.Version,
+		UserName:  version.UserName,
+		Reason:    version.Reason,
+		Fitness:   version.Fitness,
+		CreatedAt: version.CreatedAt,
+	}
+	if version.UserUUID != uuid.Nil {
+		res.UserUUID = version.UserUUID.String()
+	}
+	return res
+}
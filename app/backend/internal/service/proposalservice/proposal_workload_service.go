@@ -0,0 +1,149 @@
+package proposalservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"math"
+)
+
+// workloadTolerance absorbs the rounding of class durations expressed in fractions of an hour.
+const workloadTolerance = 0.01
+
+func (s *service) GetProposalWorkload(ctx context.Context, uuid uuid.UUID, onlyMismatches bool) (*response.ManyProfessorWorkloadsResponse, error) {
+	summary, err := s.repo.FindProposalSummaryByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("proposal not found", slog.String("package", "proposalservice"))
+			return nil, errors.New("proposal not found")
+		}
+		slog.Error("error to search proposal by id", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	return s.professorWorkloads(ctx, []entity.ProposalSummaryEntity{*summary}, onlyMismatches)
+}
+
+// GetSemesterWorkload adds up the classes of a proposal of every course in the semester, the
+// approved or published one when there is one and else the latest.
+func (s *service) GetSemesterWorkload(ctx context.Context, semesterId int64, onlyMismatches bool) (*response.ManyProfessorWorkloadsResponse, error) {
+	summaries, err := s.repo.FindLatestProposalSummariesBySemesterId(ctx, semesterId)
+	if err != nil {
+		slog.Error("error to find proposals of semester", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	if len(summaries) == 0 {
+		slog.Error("no proposals found for semester", slog.String("package", "proposalservice"))
+		return nil, errors.New("no proposals found for this semester")
+	}
+
+	return s.professorWorkloads(ctx, summaries, onlyMismatches)
+}
+
+func (s *service) professorWorkloads(ctx context.Context, summaries []entity.ProposalSummaryEntity, onlyMismatches bool) (*response.ManyProfessorWorkloadsResponse, error) {
+	proposalIds := make([]int64, 0, len(summaries))
+	courseIds := make([]int64, 0, len(summaries))
+	for _, summary := range summaries {
+		proposalIds = append(proposalIds, summary.ID)
+		courseIds = append(courseIds, summary.CourseID)
+	}
+
+	findWorkloads, err := s.repo.FindProfessorWorkloadsByProposalIds(ctx, proposalIds)
+	if err != nil {
+		slog.Error("error to find professor workloads", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	grids, err := s.timeGrids(ctx, courseIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// class hours are counted in periods of the grid of the course, the way the hours to
+	// allocate are planned
+	allocatedHours := make(map[int64]float64)
+	for _, summary := range summaries {
+		classes, err := s.repo.FindClassesByProposalID(ctx, summary.ID)
+		if err != nil {
+			slog.Error("error to find classes of proposal", "err", err, slog.String("package", "proposalservice"))
+			return nil, err
+		}
+		for _, class := range classes {
+			allocatedHours[class.ProfessorID] += process.ClassHours(class, grids[summary.CourseID])
+		}
+	}
+
+	workloads := response.ManyProfessorWorkloadsResponse{}
+	for _, workload := range findWorkloads {
+		workload.AllocatedHours = allocatedHours[workload.ProfessorID]
+		delta := workload.AllocatedHours - float64(workload.PlannedHours)
+		status := workloadStatus(delta)
+		if onlyMismatches && status == entity.WorkloadStatusExact {
+			continue
+		}
+		workloads.Workloads = append(workloads.Workloads, response.ProfessorWorkloadResponse{
+			ProfessorId:    workload.ProfessorID,
+			ProfessorUUID:  workload.ProfessorUUID.String(),
+			Name:           workload.Name,
+			PlannedHours:   workload.PlannedHours,
+			AllocatedHours: workload.AllocatedHours,
+			Delta:          delta,
+			Status:         status,
+			Classes:        workload.Classes,
+		})
+	}
+
+	return &workloads, nil
+}
+
+// timeGrid returns the periods the classes of the course are given in, the grid they are
+// generated on: the time slots of the institution or, without any, whole hours of the
+// shifts of the course.
+func (s *service) timeGrid(ctx context.Context, courseId int64) ([]entity.TimeSlotEntity, error) {
+	timeSlots, err := s.timeSlotRepo.FindManyTimeSlots(ctx)
+	if err != nil {
+		slog.Error("error to find time slots", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	shifts, err := s.shiftHoursRepo.FindShiftHoursForCourse(ctx, courseId)
+	if err != nil {
+		slog.Error("error to find shift hours of course", "err", err, slog.String("package", "proposalservice"))
+		return nil, err
+	}
+
+	return process.TimeGrid(entity.ParameterizationEntity{TimeSlots: timeSlots, Shifts: process.ResolveShifts(shifts)}), nil
+}
+
+// timeGrids returns the grid of each of the courses, by course id.
+func (s *service) timeGrids(ctx context.Context, courseIds []int64) (map[int64][]entity.TimeSlotEntity, error) {
+	grids := make(map[int64][]entity.TimeSlotEntity)
+	for _, courseId := range courseIds {
+		if _, ok := grids[courseId]; ok {
+			continue
+		}
+		grid, err := s.timeGrid(ctx, courseId)
+		if err != nil {
+			return nil, err
+		}
+		grids[courseId] = grid
+	}
+	return grids, nil
+}
+
+func workloadStatus(delta float64) string {
+	switch {
+	case math.Abs(delta) < workloadTolerance:
+		return entity.WorkloadStatusExact
+	case delta < 0:
+		return entity.WorkloadStatusUnder
+	default:
+		return entity.WorkloadStatusOver
+	}
+}
@@ -0,0 +1,27 @@
+package roomservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/roomrepository"
+)
+
+func NewRoomService(repo roomrepository.RoomRepository) RoomService {
+	return &service{
+		repo,
+	}
+}
+
+type service struct {
+	repo roomrepository.RoomRepository
+}
+
+type RoomService interface {
+	CreateRoom(ctx context.Context, u dto.CreateRoomDto) error
+	UpdateRoom(ctx context.Context, u dto.UpdateRoomDto, uuid uuid.UUID) error
+	GetRoomByID(ctx context.Context, uuid uuid.UUID) (*response.RoomResponse, error)
+	DeleteRoom(ctx context.Context, uuid uuid.UUID) error
+	FindManyRooms(ctx context.Context) (*response.ManyRoomsResponse, error)
+}
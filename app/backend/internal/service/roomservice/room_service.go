@@ -0,0 +1,119 @@
+package roomservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+)
+
+func (s *service) CreateRoom(ctx context.Context, u dto.CreateRoomDto) error {
+	newRoom := entity.RoomEntity{
+		UUID:     uuid.New(),
+		Name:     u.Name,
+		Capacity: u.Capacity,
+		Type:     u.Type,
+		Location: u.Location,
+	}
+
+	err := s.repo.CreateRoom(ctx, &newRoom)
+	if err != nil {
+		slog.Error("error to create room", "err", err, slog.String("package", "roomservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateRoom(ctx context.Context, u dto.UpdateRoomDto, uuid uuid.UUID) error {
+	_, err := s.repo.FindRoomByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("room not found", slog.String("package", "roomservice"))
+			return errors.New("room not found")
+		}
+		slog.Error("error to search room by id", "err", err, slog.String("package", "roomservice"))
+		return err
+	}
+
+	updateRoom := entity.RoomEntity{
+		UUID:     uuid,
+		Name:     u.Name,
+		Capacity: u.Capacity,
+		Type:     u.Type,
+		Location: u.Location,
+	}
+
+	err = s.repo.UpdateRoom(ctx, &updateRoom)
+	if err != nil {
+		slog.Error("error to update room", "err", err, slog.String("package", "roomservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetRoomByID(ctx context.Context, uuid uuid.UUID) (*response.RoomResponse, error) {
+	roomExists, err := s.repo.FindRoomByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("room not found", slog.String("package", "roomservice"))
+			return nil, errors.New("room not found")
+		}
+		slog.Error("error to search room by id", "err", err, slog.String("package", "roomservice"))
+		return nil, err
+	}
+
+	room := toRoomResponse(*roomExists)
+	return &room, nil
+}
+
+func (s *service) FindManyRooms(ctx context.Context) (*response.ManyRoomsResponse, error) {
+	findManyRooms, err := s.repo.FindManyRooms(ctx)
+	if err != nil {
+		slog.Error("error to find many rooms", "err", err, slog.String("package", "roomservice"))
+		return nil, err
+	}
+
+	rooms := response.ManyRoomsResponse{}
+	for _, roomEntity := range findManyRooms {
+		rooms.Rooms = append(rooms.Rooms, toRoomResponse(roomEntity))
+	}
+
+	return &rooms, nil
+}
+
+func (s *service) DeleteRoom(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindRoomByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("room not found", slog.String("package", "roomservice"))
+			return errors.New("room not found")
+		}
+		slog.Error("error to search room by id", "err", err, slog.String("package", "roomservice"))
+		return err
+	}
+
+	err = s.repo.DeleteRoom(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete room", "err", err, slog.String("package", "roomservice"))
+		return err
+	}
+
+	return nil
+}
+
+func toRoomResponse(room entity.RoomEntity) response.RoomResponse {
+	return response.RoomResponse{
+		Id:       room.ID,
+		UUID:     room.UUID.String(),
+		Name:     room.Name,
+		Capacity: room.Capacity,
+		Type:     room.Type,
+		Location: room.Location,
+	}
+}
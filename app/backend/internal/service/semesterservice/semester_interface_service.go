@@ -24,4 +24,7 @@ type SemesterService interface {
 	GetSemesterByID(ctx context.Context, uuid uuid.UUID) (*response.SemesterResponse, error)
 	DeleteSemester(ctx context.Context, uuid uuid.UUID) error
 	FindManySemesters(ctx context.Context) (*response.ManySemestersResponse, error)
+	CreateSemesterHoliday(ctx context.Context, u dto.CreateSemesterHolidayDto) error
+	UpdateSemesterHoliday(ctx context.Context, u dto.UpdateSemesterHolidayDto, uuid uuid.UUID) error
+	DeleteSemesterHoliday(ctx context.Context, uuid uuid.UUID) error
 }
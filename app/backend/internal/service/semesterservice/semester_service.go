@@ -9,16 +9,34 @@ import (
 	"github.com/robinsonvs/time-table-project/internal/entity"
 	"github.com/robinsonvs/time-table-project/internal/handler/response"
 	"log/slog"
+	"time"
 )
 
+// dateLayout is how the days of the semester calendar are written.
+const dateLayout = "2006-01-02"
+
 func (s *service) CreateSemester(ctx context.Context, u dto.CreateSemesterDto) error {
+	startDate, err := parseDate(u.StartDate)
+	if err != nil {
+		return err
+	}
+	endDate, err := parseDate(u.EndDate)
+	if err != nil {
+		return err
+	}
+	err = checkSemesterDates(startDate, endDate)
+	if err != nil {
+		return err
+	}
 
 	newSemester := entity.SemesterEntity{
-		UUID:     uuid.New(),
-		Semester: u.Semester,
+		UUID:      uuid.New(),
+		Semester:  u.Semester,
+		StartDate: startDate,
+		EndDate:   endDate,
 	}
 
-	err := s.repo.CreateSemester(ctx, &newSemester)
+	err = s.repo.CreateSemester(ctx, &newSemester)
 	if err != nil {
 		slog.Error("error to create semester", "err", err, slog.String("package", "semesterservice"))
 		return err
@@ -43,9 +61,30 @@ func (s *service) UpdateSemester(ctx context.Context, u dto.UpdateSemesterDto, u
 		return errors.New("semester already exists")
 	}
 
+	// dates left out of the body keep their current value
+	startDate, endDate := semesterExists.StartDate, semesterExists.EndDate
+	if u.StartDate != "" {
+		startDate, err = parseDate(u.StartDate)
+		if err != nil {
+			return err
+		}
+	}
+	if u.EndDate != "" {
+		endDate, err = parseDate(u.EndDate)
+		if err != nil {
+			return err
+		}
+	}
+	err = checkSemesterDates(startDate, endDate)
+	if err != nil {
+		return err
+	}
+
 	updateSemester := entity.SemesterEntity{
-		UUID:     uuid,
-		Semester: u.Semester,
+		UUID:      uuid,
+		Semester:  u.Semester,
+		StartDate: startDate,
+		EndDate:   endDate,
 	}
 
 	err = s.repo.UpdateSemester(ctx, &updateSemester)
@@ -69,11 +108,13 @@ func (s *service) GetSemesterByID(ctx context.Context, uuid uuid.UUID) (*respons
 		return nil, errors.New("semester not found")
 	}
 
-	semester := response.SemesterResponse{
-		UUID:     semesterExists.UUID.String(),
-		Semester: semesterExists.Semester,
+	semesterExists.Holidays, err = s.repo.FindManySemesterHolidaysBySemesterId(ctx, semesterExists.ID)
+	if err != nil {
+		slog.Error("error to find semester holidays", "err", err, slog.String("package", "semesterservice"))
+		return nil, err
 	}
 
+	semester := toSemesterResponse(*semesterExists)
 	return &semester, nil
 }
 
@@ -86,12 +127,7 @@ func (s *service) FindManySemesters(ctx context.Context) (*response.ManySemester
 
 	semesters := response.ManySemestersResponse{}
 	for _, semesterEntity := range findManySemesters {
-		semesterResponse := response.SemesterResponse{
-			Id:       semesterEntity.ID,
-			UUID:     semesterEntity.UUID.String(),
-			Semester: semesterEntity.Semester,
-		}
-		semesters.Semesters = append(semesters.Semesters, semesterResponse)
+		semesters.Semesters = append(semesters.Semesters, toSemesterResponse(semesterEntity))
 	}
 
 	return &semesters, nil
@@ -117,3 +153,164 @@ func (s *service) DeleteSemester(ctx context.Context, uuid uuid.UUID) error {
 
 	return nil
 }
+
+func (s *service) CreateSemesterHoliday(ctx context.Context, u dto.CreateSemesterHolidayDto) error {
+	semester, err := s.repo.FindSemesterBySemesterId(ctx, u.SemesterId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("semester not found", slog.String("package", "semesterservice"))
+			return errors.New("semester not found")
+		}
+		slog.Error("error to search semester by id", "err", err, slog.String("package", "semesterservice"))
+		return err
+	}
+
+	date, err := time.Parse(dateLayout, u.Date)
+	if err != nil {
+		return err
+	}
+
+	newHoliday := entity.SemesterHolidayEntity{
+		UUID:        uuid.New(),
+		SemesterID:  semester.ID,
+		Date:        date,
+		Description: u.Description,
+	}
+
+	err = s.validateSemesterHoliday(ctx, *semester, newHoliday)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.CreateSemesterHoliday(ctx, &newHoliday)
+	if err != nil {
+		slog.Error("error to create semester holiday", "err", err, slog.String("package", "semesterservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateSemesterHoliday(ctx context.Context, u dto.UpdateSemesterHolidayDto, uuid uuid.UUID) error {
+	holidayExists, err := s.repo.FindSemesterHolidayByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("semester holiday not found", slog.String("package", "semesterservice"))
+			return errors.New("semester holiday not found")
+		}
+		slog.Error("error to search semester holiday by id", "err", err, slog.String("package", "semesterservice"))
+		return err
+	}
+
+	// only the fields sent in the body are changed
+	if u.Description != "" {
+		holidayExists.Description = u.Description
+	}
+	if u.Date != "" {
+		holidayExists.Date, err = time.Parse(dateLayout, u.Date)
+		if err != nil {
+			return err
+		}
+
+		semester, err := s.repo.FindSemesterBySemesterId(ctx, holidayExists.SemesterID)
+		if err != nil {
+			slog.Error("error to search semester by id", "err", err, slog.String("package", "semesterservice"))
+			return err
+		}
+		err = s.validateSemesterHoliday(ctx, *semester, *holidayExists)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.repo.UpdateSemesterHoliday(ctx, holidayExists)
+	if err != nil {
+		slog.Error("error to update semester holiday", "err", err, slog.String("package", "semesterservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) DeleteSemesterHoliday(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindSemesterHolidayByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("semester holiday not found", slog.String("package", "semesterservice"))
+			return errors.New("semester holiday not found")
+		}
+		slog.Error("error to search semester holiday by id", "err", err, slog.String("package", "semesterservice"))
+		return err
+	}
+
+	err = s.repo.DeleteSemesterHoliday(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete semester holiday", "err", err, slog.String("package", "semesterservice"))
+		return err
+	}
+
+	return nil
+}
+
+// validateSemesterHoliday checks that the holiday falls inside the semester, when its
+// calendar is set, and that the semester has no other holiday on the same day.
+func (s *service) validateSemesterHoliday(ctx context.Context, semester entity.SemesterEntity, holiday entity.SemesterHolidayEntity) error {
+	if !semester.StartDate.IsZero() && (holiday.Date.Before(semester.StartDate) || holiday.Date.After(semester.EndDate)) {
+		slog.Error("holiday is outside the semester", slog.String("package", "semesterservice"))
+		return errors.New("holiday is outside the semester")
+	}
+
+	sameDay, err := s.repo.FindSemesterHolidayByDate(ctx, semester.ID, holiday.Date)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search semester holiday by date", "err", err, slog.String("package", "semesterservice"))
+		return err
+	}
+	if sameDay != nil && sameDay.UUID != holiday.UUID {
+		slog.Error("semester already has a holiday on this date", slog.String("package", "semesterservice"))
+		return errors.New("semester already has a holiday on this date")
+	}
+
+	return nil
+}
+
+// parseDate reads a day of the semester calendar; an empty value is the zero time.
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(dateLayout, value)
+}
+
+func checkSemesterDates(startDate, endDate time.Time) error {
+	if startDate.IsZero() != endDate.IsZero() {
+		slog.Error("start date and end date must be set together", slog.String("package", "semesterservice"))
+		return errors.New("start date and end date must be set together")
+	}
+	if endDate.Before(startDate) {
+		slog.Error("end date must not be before start date", slog.String("package", "semesterservice"))
+		return errors.New("end date must not be before start date")
+	}
+	return nil
+}
+
+func toSemesterResponse(semester entity.SemesterEntity) response.SemesterResponse {
+	semesterResponse := response.SemesterResponse{
+		Id:       semester.ID,
+		UUID:     semester.UUID.String(),
+		Semester: semester.Semester,
+	}
+	if !semester.StartDate.IsZero() {
+		semesterResponse.StartDate = semester.StartDate.Format(dateLayout)
+		semesterResponse.EndDate = semester.EndDate.Format(dateLayout)
+	}
+	for _, holiday := range semester.Holidays {
+		semesterResponse.Holidays = append(semesterResponse.Holidays, response.SemesterHolidayResponse{
+			Id:          holiday.ID,
+			UUID:        holiday.UUID.String(),
+			SemesterId:  holiday.SemesterID,
+			Date:        holiday.Date.Format(dateLayout),
+			Description: holiday.Description,
+		})
+	}
+	return semesterResponse
+}
@@ -0,0 +1,28 @@
+package shifthoursservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/shifthoursrepository"
+)
+
+func NewShiftHoursService(repo shifthoursrepository.ShiftHoursRepository) ShiftHoursService {
+	return &service{
+		repo,
+	}
+}
+
+type service struct {
+	repo shifthoursrepository.ShiftHoursRepository
+}
+
+type ShiftHoursService interface {
+	CreateShiftHours(ctx context.Context, u dto.CreateShiftHoursDto) error
+	UpdateShiftHours(ctx context.Context, u dto.UpdateShiftHoursDto, uuid uuid.UUID) error
+	GetShiftHoursByID(ctx context.Context, uuid uuid.UUID) (*response.ShiftHoursResponse, error)
+	DeleteShiftHours(ctx context.Context, uuid uuid.UUID) error
+	FindManyShiftHours(ctx context.Context) (*response.ManyShiftHoursResponse, error)
+	FindShiftHoursForCourse(ctx context.Context, courseId int64) (*response.ManyShiftHoursResponse, error)
+}
@@ -0,0 +1,185 @@
+package shifthoursservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"time"
+)
+
+// timeOfDayLayout is how the start and end of shifts are written.
+const timeOfDayLayout = "15:04"
+
+func (s *service) CreateShiftHours(ctx context.Context, u dto.CreateShiftHoursDto) error {
+	if u.CourseId != 0 && u.Location != "" {
+		slog.Error("shift hours are set for a course or a location, not both", slog.String("package", "shifthoursservice"))
+		return errors.New("shift hours are set for a course or a location, not both")
+	}
+
+	startTime, err := time.Parse(timeOfDayLayout, u.StartTime)
+	if err != nil {
+		return err
+	}
+	endTime, err := time.Parse(timeOfDayLayout, u.EndTime)
+	if err != nil {
+		return err
+	}
+	if !endTime.After(startTime) {
+		slog.Error("end time must be after start time", slog.String("package", "shifthoursservice"))
+		return errors.New("end time must be after start time")
+	}
+
+	_, err = s.repo.FindShiftHoursByScope(ctx, u.Shift, u.CourseId, u.Location)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search shift hours by scope", "err", err, slog.String("package", "shifthoursservice"))
+		return err
+	}
+	if err == nil {
+		slog.Error("shift hours already exist for this scope", slog.String("package", "shifthoursservice"))
+		return errors.New("shift hours already exist for this scope")
+	}
+
+	newShiftHours := entity.ShiftHoursEntity{
+		UUID:      uuid.New(),
+		Shift:     u.Shift,
+		StartTime: startTime,
+		EndTime:   endTime,
+		CourseID:  u.CourseId,
+		Location:  u.Location,
+	}
+
+	err = s.repo.CreateShiftHours(ctx, &newShiftHours)
+	if err != nil {
+		slog.Error("error to create shift hours", "err", err, slog.String("package", "shifthoursservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateShiftHours(ctx context.Context, u dto.UpdateShiftHoursDto, uuid uuid.UUID) error {
+	shiftHoursExists, err := s.repo.FindShiftHoursByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("shift hours not found", slog.String("package", "shifthoursservice"))
+			return errors.New("shift hours not found")
+		}
+		slog.Error("error to search shift hours by id", "err", err, slog.String("package", "shifthoursservice"))
+		return err
+	}
+
+	updateShiftHours := *shiftHoursExists
+	if u.StartTime != "" {
+		updateShiftHours.StartTime, err = time.Parse(timeOfDayLayout, u.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+	if u.EndTime != "" {
+		updateShiftHours.EndTime, err = time.Parse(timeOfDayLayout, u.EndTime)
+		if err != nil {
+			return err
+		}
+	}
+	if !updateShiftHours.EndTime.After(updateShiftHours.StartTime) {
+		slog.Error("end time must be after start time", slog.String("package", "shifthoursservice"))
+		return errors.New("end time must be after start time")
+	}
+
+	err = s.repo.UpdateShiftHours(ctx, &updateShiftHours)
+	if err != nil {
+		slog.Error("error to update shift hours", "err", err, slog.String("package", "shifthoursservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetShiftHoursByID(ctx context.Context, uuid uuid.UUID) (*response.ShiftHoursResponse, error) {
+	shiftHoursExists, err := s.repo.FindShiftHoursByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("shift hours not found", slog.String("package", "shifthoursservice"))
+			return nil, errors.New("shift hours not found")
+		}
+		slog.Error("error to search shift hours by id", "err", err, slog.String("package", "shifthoursservice"))
+		return nil, err
+	}
+
+	shiftHours := toShiftHoursResponse(*shiftHoursExists)
+	return &shiftHours, nil
+}
+
+func (s *service) DeleteShiftHours(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindShiftHoursByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("shift hours not found", slog.String("package", "shifthoursservice"))
+			return errors.New("shift hours not found")
+		}
+		slog.Error("error to search shift hours by id", "err", err, slog.String("package", "shifthoursservice"))
+		return err
+	}
+
+	err = s.repo.DeleteShiftHours(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete shift hours", "err", err, slog.String("package", "shifthoursservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) FindManyShiftHours(ctx context.Context) (*response.ManyShiftHoursResponse, error) {
+	findManyShiftHours, err := s.repo.FindManyShiftHours(ctx)
+	if err != nil {
+		slog.Error("error to find many shift hours", "err", err, slog.String("package", "shifthoursservice"))
+		return nil, err
+	}
+
+	shiftHours := response.ManyShiftHoursResponse{}
+	for _, shiftHoursEntity := range findManyShiftHours {
+		shiftHours.ShiftHours = append(shiftHours.ShiftHours, toShiftHoursResponse(shiftHoursEntity))
+	}
+
+	return &shiftHours, nil
+}
+
+// FindShiftHoursForCourse returns the hours the course schedules classes in: for each
+// shift the most specific configuration that applies to it, or the default hours.
+func (s *service) FindShiftHoursForCourse(ctx context.Context, courseId int64) (*response.ManyShiftHoursResponse, error) {
+	configured, err := s.repo.FindShiftHoursForCourse(ctx, courseId)
+	if err != nil {
+		slog.Error("error to find shift hours for course", "err", err, slog.String("package", "shifthoursservice"))
+		return nil, err
+	}
+
+	shiftHours := response.ManyShiftHoursResponse{}
+	for _, shiftHoursEntity := range process.ResolveShifts(configured) {
+		shiftHours.ShiftHours = append(shiftHours.ShiftHours, toShiftHoursResponse(shiftHoursEntity))
+	}
+
+	return &shiftHours, nil
+}
+
+func toShiftHoursResponse(shiftHours entity.ShiftHoursEntity) response.ShiftHoursResponse {
+	shiftHoursResponse := response.ShiftHoursResponse{
+		Id:        shiftHours.ID,
+		Shift:     shiftHours.Shift,
+		StartTime: shiftHours.StartTime.Format(timeOfDayLayout),
+		EndTime:   shiftHours.EndTime.Format(timeOfDayLayout),
+		CourseId:  shiftHours.CourseID,
+		Location:  shiftHours.Location,
+	}
+	// the default hours are not stored and have no uuid
+	if shiftHours.UUID != uuid.Nil {
+		shiftHoursResponse.UUID = shiftHours.UUID.String()
+	}
+	return shiftHoursResponse
+}
@@ -0,0 +1,27 @@
+package timeslotservice
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"github.com/robinsonvs/time-table-project/internal/repository/timeslotrepository"
+)
+
+func NewTimeSlotService(repo timeslotrepository.TimeSlotRepository) TimeSlotService {
+	return &service{
+		repo,
+	}
+}
+
+type service struct {
+	repo timeslotrepository.TimeSlotRepository
+}
+
+type TimeSlotService interface {
+	CreateTimeSlot(ctx context.Context, u dto.CreateTimeSlotDto) error
+	UpdateTimeSlot(ctx context.Context, u dto.UpdateTimeSlotDto, uuid uuid.UUID) error
+	GetTimeSlotByID(ctx context.Context, uuid uuid.UUID) (*response.TimeSlotResponse, error)
+	DeleteTimeSlot(ctx context.Context, uuid uuid.UUID) error
+	FindManyTimeSlots(ctx context.Context) (*response.ManyTimeSlotsResponse, error)
+}
@@ -0,0 +1,199 @@
+package timeslotservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/robinsonvs/time-table-project/internal/core/process"
+	"github.com/robinsonvs/time-table-project/internal/dto"
+	"github.com/robinsonvs/time-table-project/internal/entity"
+	"github.com/robinsonvs/time-table-project/internal/handler/response"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// timeOfDayLayout is how the start and end of time slots are written.
+const timeOfDayLayout = "15:04"
+
+func (s *service) CreateTimeSlot(ctx context.Context, u dto.CreateTimeSlotDto) error {
+	startTime, err := time.Parse(timeOfDayLayout, u.StartTime)
+	if err != nil {
+		return err
+	}
+	endTime, err := time.Parse(timeOfDayLayout, u.EndTime)
+	if err != nil {
+		return err
+	}
+
+	newTimeSlot := entity.TimeSlotEntity{
+		UUID:      uuid.New(),
+		Label:     u.Label,
+		Shift:     u.Shift,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Weekdays:  u.Weekdays,
+	}
+
+	err = s.validateTimeSlot(ctx, newTimeSlot)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.CreateTimeSlot(ctx, &newTimeSlot)
+	if err != nil {
+		slog.Error("error to create time slot", "err", err, slog.String("package", "timeslotservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) UpdateTimeSlot(ctx context.Context, u dto.UpdateTimeSlotDto, uuid uuid.UUID) error {
+	timeSlotExists, err := s.repo.FindTimeSlotByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("time slot not found", slog.String("package", "timeslotservice"))
+			return errors.New("time slot not found")
+		}
+		slog.Error("error to search time slot by id", "err", err, slog.String("package", "timeslotservice"))
+		return err
+	}
+
+	updateTimeSlot := *timeSlotExists
+	if u.Label != "" {
+		updateTimeSlot.Label = u.Label
+	}
+	if u.Shift != "" {
+		updateTimeSlot.Shift = u.Shift
+	}
+	if u.StartTime != "" {
+		updateTimeSlot.StartTime, err = time.Parse(timeOfDayLayout, u.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+	if u.EndTime != "" {
+		updateTimeSlot.EndTime, err = time.Parse(timeOfDayLayout, u.EndTime)
+		if err != nil {
+			return err
+		}
+	}
+	if u.Weekdays != nil {
+		updateTimeSlot.Weekdays = u.Weekdays
+	}
+
+	err = s.validateTimeSlot(ctx, updateTimeSlot)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateTimeSlot(ctx, &updateTimeSlot)
+	if err != nil {
+		slog.Error("error to update time slot", "err", err, slog.String("package", "timeslotservice"))
+		return err
+	}
+
+	return nil
+}
+
+// validateTimeSlot checks that the slot ends after it starts, that its label is free
+// and that it does not overlap a slot given on any of its days.
+func (s *service) validateTimeSlot(ctx context.Context, timeSlot entity.TimeSlotEntity) error {
+	if !timeSlot.EndTime.After(timeSlot.StartTime) {
+		slog.Error("end time must be after start time", slog.String("package", "timeslotservice"))
+		return errors.New("end time must be after start time")
+	}
+
+	labelExists, err := s.repo.FindTimeSlotByLabel(ctx, timeSlot.Label)
+	if err != nil && err != sql.ErrNoRows {
+		slog.Error("error to search time slot by label", "err", err, slog.String("package", "timeslotservice"))
+		return err
+	}
+	if labelExists != nil && labelExists.UUID != timeSlot.UUID {
+		slog.Error("time slot label already exists", slog.String("package", "timeslotservice"))
+		return errors.New("time slot label already exists")
+	}
+
+	timeSlots, err := s.repo.FindManyTimeSlots(ctx)
+	if err != nil {
+		slog.Error("error to find many time slots", "err", err, slog.String("package", "timeslotservice"))
+		return err
+	}
+	for _, other := range timeSlots {
+		if other.UUID == timeSlot.UUID || !timeSlot.StartTime.Before(other.EndTime) || !other.StartTime.Before(timeSlot.EndTime) {
+			continue
+		}
+		for _, day := range process.SlotWeekdays(timeSlot) {
+			if slices.Contains(process.SlotWeekdays(other), day) {
+				slog.Error("time slot overlaps another one", slog.String("package", "timeslotservice"))
+				return errors.New("time slot overlaps another one")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *service) GetTimeSlotByID(ctx context.Context, uuid uuid.UUID) (*response.TimeSlotResponse, error) {
+	timeSlotExists, err := s.repo.FindTimeSlotByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("time slot not found", slog.String("package", "timeslotservice"))
+			return nil, errors.New("time slot not found")
+		}
+		slog.Error("error to search time slot by id", "err", err, slog.String("package", "timeslotservice"))
+		return nil, err
+	}
+
+	timeSlot := toTimeSlotResponse(*timeSlotExists)
+	return &timeSlot, nil
+}
+
+func (s *service) DeleteTimeSlot(ctx context.Context, uuid uuid.UUID) error {
+	_, err := s.repo.FindTimeSlotByID(ctx, uuid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.Error("time slot not found", slog.String("package", "timeslotservice"))
+			return errors.New("time slot not found")
+		}
+		slog.Error("error to search time slot by id", "err", err, slog.String("package", "timeslotservice"))
+		return err
+	}
+
+	err = s.repo.DeleteTimeSlot(ctx, uuid)
+	if err != nil {
+		slog.Error("error to delete time slot", "err", err, slog.String("package", "timeslotservice"))
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) FindManyTimeSlots(ctx context.Context) (*response.ManyTimeSlotsResponse, error) {
+	findManyTimeSlots, err := s.repo.FindManyTimeSlots(ctx)
+	if err != nil {
+		slog.Error("error to find many time slots", "err", err, slog.String("package", "timeslotservice"))
+		return nil, err
+	}
+
+	timeSlots := response.ManyTimeSlotsResponse{}
+	for _, timeSlotEntity := range findManyTimeSlots {
+		timeSlots.TimeSlots = append(timeSlots.TimeSlots, toTimeSlotResponse(timeSlotEntity))
+	}
+
+	return &timeSlots, nil
+}
+
+func toTimeSlotResponse(timeSlot entity.TimeSlotEntity) response.TimeSlotResponse {
+	return response.TimeSlotResponse{
+		Id:        timeSlot.ID,
+		UUID:      timeSlot.UUID.String(),
+		Label:     timeSlot.Label,
+		Shift:     timeSlot.Shift,
+		StartTime: timeSlot.StartTime.Format(timeOfDayLayout),
+		EndTime:   timeSlot.EndTime.Format(timeOfDayLayout),
+		Weekdays:  process.SlotWeekdays(timeSlot),
+	}
+}
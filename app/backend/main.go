@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/go-chi/chi"
 	"github.com/robinsonvs/time-table-project/config/env"
@@ -13,19 +14,36 @@ import (
 	"github.com/robinsonvs/time-table-project/internal/handler/routes"
 	"github.com/robinsonvs/time-table-project/internal/repository/availabilityrepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/courserepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/curriculummatrixrepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/disciplinerepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/eligibledisciplinerepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/lockedclassrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationconstraintrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationdisciplinerepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/parameterizationrepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/professorrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/proposaljobrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/proposalrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/roomrepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/semesterrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/shifthoursrepository"
+	"github.com/robinsonvs/time-table-project/internal/repository/timeslotrepository"
 	"github.com/robinsonvs/time-table-project/internal/repository/userrepository"
 	"github.com/robinsonvs/time-table-project/internal/service/availabilityservice"
 	"github.com/robinsonvs/time-table-project/internal/service/courseservice"
+	"github.com/robinsonvs/time-table-project/internal/service/curriculummatrixservice"
 	"github.com/robinsonvs/time-table-project/internal/service/disciplineservice"
 	"github.com/robinsonvs/time-table-project/internal/service/eligibledisciplineservice"
+	"github.com/robinsonvs/time-table-project/internal/service/lockedclassservice"
+	"github.com/robinsonvs/time-table-project/internal/service/parameterizationconstraintservice"
+	"github.com/robinsonvs/time-table-project/internal/service/parameterizationdisciplineservice"
 	"github.com/robinsonvs/time-table-project/internal/service/parameterizationservice"
 	"github.com/robinsonvs/time-table-project/internal/service/professorservice"
+	"github.com/robinsonvs/time-table-project/internal/service/proposalservice"
+	"github.com/robinsonvs/time-table-project/internal/service/roomservice"
 	"github.com/robinsonvs/time-table-project/internal/service/semesterservice"
+	"github.com/robinsonvs/time-table-project/internal/service/shifthoursservice"
+	"github.com/robinsonvs/time-table-project/internal/service/timeslotservice"
 	"github.com/robinsonvs/time-table-project/internal/service/userservice"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"log/slog"
@@ -70,6 +88,15 @@ func main() {
 	availabilityRepo := availabilityrepository.NewAvailabilityRepository(dbConnection, queries)
 	eligibleDisciplineRepo := eligibledisciplinerepository.NewEligibleDisciplineRepository(dbConnection, queries)
 	parameterizationRepo := parameterizationrepository.NewParameterizationRepository(dbConnection, queries)
+	proposalJobRepo := proposaljobrepository.NewProposalJobRepository(dbConnection, queries)
+	proposalRepo := proposalrepository.NewProposalRepository(dbConnection, queries)
+	parameterizationDisciplineRepo := parameterizationdisciplinerepository.NewParameterizationDisciplineRepository(dbConnection, queries)
+	roomRepo := roomrepository.NewRoomRepository(dbConnection, queries)
+	parameterizationConstraintRepo := parameterizationconstraintrepository.NewParameterizationConstraintRepository(dbConnection, queries)
+	curriculumMatrixRepo := curriculummatrixrepository.NewCurriculumMatrixRepository(dbConnection, queries)
+	shiftHoursRepo := shifthoursrepository.NewShiftHoursRepository(dbConnection, queries)
+	timeSlotRepo := timeslotrepository.NewTimeSlotRepository(dbConnection, queries)
+	lockedClassRepo := lockedclassrepository.NewLockedClassRepository(dbConnection, queries)
 
 	newUserService := userservice.NewUserService(userRepo)
 	newCourseService := courseservice.NewCourseService(courseRepo)
@@ -79,12 +106,26 @@ func main() {
 	newAvailabilityService := availabilityservice.NewAvailabilityService(availabilityRepo)
 	newParameterizationService := parameterizationservice.NewParameterizationService(parameterizationRepo)
 	newEligibleDisciplineService := eligibledisciplineservice.NewEligibleDisciplineService(eligibleDisciplineRepo)
+	newProposalService := proposalservice.NewProposalService(proposalRepo, timeSlotRepo, shiftHoursRepo, semesterRepo, professorRepo)
+	newParameterizationDisciplineService := parameterizationdisciplineservice.NewParameterizationDisciplineService(parameterizationDisciplineRepo)
+	newRoomService := roomservice.NewRoomService(roomRepo)
+	newParameterizationConstraintService := parameterizationconstraintservice.NewParameterizationConstraintService(parameterizationConstraintRepo)
+	newCurriculumMatrixService := curriculummatrixservice.NewCurriculumMatrixService(curriculumMatrixRepo)
+	newShiftHoursService := shifthoursservice.NewShiftHoursService(shiftHoursRepo)
+	newTimeSlotService := timeslotservice.NewTimeSlotService(timeSlotRepo)
+	newLockedClassService := lockedclassservice.NewLockedClassService(lockedClassRepo, proposalRepo)
 
-	newGeneticAlgorithmService := service.NewGeneticAlgorithmService(disciplineRepo, professorRepo, availabilityRepo, parameterizationRepo)
+	newGeneticAlgorithmService := service.NewGeneticAlgorithmService(disciplineRepo, professorRepo, availabilityRepo, parameterizationRepo, proposalJobRepo, parameterizationDisciplineRepo, roomRepo, parameterizationConstraintRepo, curriculumMatrixRepo, shiftHoursRepo, timeSlotRepo, semesterRepo, lockedClassRepo, proposalRepo)
+
+	err = newGeneticAlgorithmService.ResumeProposalJobs(context.Background())
+	if err != nil {
+		slog.Error("error to resume proposal jobs", "err", err, slog.String("package", "main"))
+	}
+	newGeneticAlgorithmService.StartProposalJobWorkers()
 
 	newHandler := handler.NewHandler(newUserService,
 		newCourseService, newSemesterService, newProfessorService,
-		newDisciplineService, newAvailabilityService, newParameterizationService, newEligibleDisciplineService, newGeneticAlgorithmService)
+		newDisciplineService, newAvailabilityService, newParameterizationService, newEligibleDisciplineService, newGeneticAlgorithmService, newProposalService, newParameterizationDisciplineService, newRoomService, newParameterizationConstraintService, newCurriculumMatrixService, newShiftHoursService, newTimeSlotService, newLockedClassService)
 
 	//enableCors(router)
 